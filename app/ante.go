@@ -9,8 +9,11 @@ import (
 	circuitante "cosmossdk.io/x/circuit/ante"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/rollchains/tlock/app/decorators"
 )
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
@@ -20,6 +23,9 @@ type HandlerOptions struct {
 
 	IBCKeeper     *keeper.Keeper
 	CircuitKeeper *circuitkeeper.Keeper
+	// RateLimitKeeper enforces the per address quotas of the social messages
+	RateLimitKeeper decorators.RateLimitKeeper
+	Codec           codec.Codec
 
 	BypassMinFeeMsgTypes []string
 }
@@ -38,6 +44,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.CircuitKeeper == nil {
 		return nil, errors.New("circuit keeper is required for ante builder")
 	}
+	if options.RateLimitKeeper == nil {
+		return nil, errors.New("rate limit keeper is required for ante builder")
+	}
+	if options.Codec == nil {
+		return nil, errors.New("codec is required for ante builder")
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
@@ -53,6 +65,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		decorators.NewRateLimitDecorator(options.RateLimitKeeper, options.Codec), // after signature verification so forged txs do not use up a quota
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
 	}
 
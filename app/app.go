@@ -652,6 +652,8 @@ func NewChainApp(
 		app.FeeGrantKeeper,
 		app.ProfileKeeper,
 	)
+	// the post keeper re-indexes the feeds when an account changes its privacy
+	app.ProfileKeeper.SetHooks(app.PostKeeper.Hooks())
 
 	// post module account permissions
 	maccPerms[posttypes.ModuleName] = []string{authtypes.Minter, authtypes.Burner}
@@ -986,8 +988,10 @@ func NewChainApp(
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCKeeper:     app.IBCKeeper,
-			CircuitKeeper: &app.CircuitKeeper,
+			IBCKeeper:       app.IBCKeeper,
+			CircuitKeeper:   &app.CircuitKeeper,
+			RateLimitKeeper: app.ProfileKeeper,
+			Codec:           app.appCodec,
 		},
 	)
 	if err != nil {
@@ -0,0 +1,72 @@
+package decorators
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// RateLimitKeeper counts the messages an address sends and rejects the ones
+// over its quota.
+type RateLimitKeeper interface {
+	ConsumeRateLimit(ctx sdk.Context, address string, msgType string) error
+}
+
+// RateLimitDecorator enforces the per address quotas of the social messages,
+// the quotas are governance params of the profile module.
+type RateLimitDecorator struct {
+	keeper RateLimitKeeper
+	cdc    codec.Codec
+}
+
+// NewRateLimitDecorator returns a new RateLimitDecorator. The codec is used to
+// find the signers of the messages.
+func NewRateLimitDecorator(keeper RateLimitKeeper, cdc codec.Codec) RateLimitDecorator {
+	return RateLimitDecorator{
+		keeper: keeper,
+		cdc:    cdc,
+	}
+}
+
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// the messages were counted when the tx first entered the mempool
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	if err := rld.ConsumeRateLimits(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ConsumeRateLimits counts every message against the quota of its signers,
+// including the messages nested in an authz MsgExec.
+func (rld RateLimitDecorator) ConsumeRateLimits(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		// check nested messages in a recursive manner
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			msgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := rld.ConsumeRateLimits(ctx, msgs); err != nil {
+				return err
+			}
+		}
+
+		signers, _, err := rld.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return err
+		}
+		msgType := sdk.MsgTypeURL(msg)
+		for _, signer := range signers {
+			if err := rld.keeper.ConsumeRateLimit(ctx, sdk.AccAddress(signer).String(), msgType); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
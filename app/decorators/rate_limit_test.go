@@ -0,0 +1,53 @@
+package decorators_test
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/rollchains/tlock/app/decorators"
+)
+
+// mockRateLimitKeeper allows every address a fixed number of messages per type
+type mockRateLimitKeeper struct {
+	limit  int
+	counts map[string]int
+}
+
+func (k *mockRateLimitKeeper) ConsumeRateLimit(_ sdk.Context, address string, msgType string) error {
+	key := address + msgType
+	if k.counts[key] >= k.limit {
+		return errors.New("rate limit exceeded")
+	}
+	k.counts[key]++
+	return nil
+}
+
+func (s *AnteTestSuite) TestAnteRateLimit() {
+	acc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	keeper := &mockRateLimitKeeper{limit: 2, counts: make(map[string]int)}
+	ante := decorators.NewRateLimitDecorator(keeper, cdc)
+	msg := banktypes.NewMsgSend(acc, acc, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))))
+
+	for i := 0; i < 2; i++ {
+		_, err := ante.AnteHandle(s.ctx, decorators.NewMockTx(msg), false, decorators.EmptyAnte)
+		s.Require().NoError(err)
+	}
+	_, err := ante.AnteHandle(s.ctx, decorators.NewMockTx(msg), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+
+	// the nested message still counts against the granter
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(&exec), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+	s.Require().Equal(2, keeper.counts[acc.String()+sdk.MsgTypeURL(msg)])
+}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	profilekeeper "github.com/rollchains/tlock/x/profile/keeper"
+	profiletypes "github.com/rollchains/tlock/x/profile/types"
+)
+
+// TestProfileGenesisRoundTrip exports a populated x/profile state, imports it
+// into a fresh chain and checks that both stores hold the same key-values.
+func TestProfileGenesisRoundTrip(t *testing.T) {
+	appA := Setup(t)
+	ctxA := appA.NewContextLegacy(false, cmtproto.Header{Height: 1, Time: time.Unix(1000, 0)})
+	msgServer := profilekeeper.NewMsgServerImpl(appA.ProfileKeeper)
+
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	alice, bob, carol := addrs[0].String(), addrs[1].String(), addrs[2].String()
+	for i, profile := range []*profiletypes.ProfileOptions{
+		{Nickname: "Alice", UserHandle: "alice", Avatar: "avatar"},
+		{Nickname: "Bob", UserHandle: "bob"},
+		{Nickname: "Carol"},
+	} {
+		_, err := msgServer.AddProfile(ctxA, &profiletypes.MsgAddProfileRequest{Creator: addrs[i].String(), ProfileJson: profile})
+		require.NoError(t, err)
+	}
+
+	ctxA = ctxA.WithBlockTime(time.Unix(2000, 0))
+	for _, follow := range [][2]string{{bob, alice}, {carol, alice}, {alice, bob}} {
+		_, err := msgServer.Follow(ctxA, &profiletypes.MsgFollowRequest{Creator: follow[0], TargetAddr: follow[1]})
+		require.NoError(t, err)
+	}
+	require.NoError(t, appA.ProfileKeeper.AddAdmin(ctxA, alice))
+	require.NoError(t, appA.ProfileKeeper.AddEditableAdmin(ctxA, bob))
+	appA.ProfileKeeper.StoreMessage(ctxA, alice, bob, "HASH")
+	appA.ProfileKeeper.SetMessageCount(ctxA, alice, bob, 1)
+
+	genesis := appA.ProfileKeeper.ExportGenesis(ctxA)
+	require.NoError(t, genesis.Validate())
+	bz, err := appA.AppCodec().MarshalJSON(genesis)
+	require.NoError(t, err)
+
+	appB := Setup(t)
+	ctxB := appB.NewContextLegacy(false, cmtproto.Header{Height: 1})
+	var imported profiletypes.GenesisState
+	require.NoError(t, appB.AppCodec().UnmarshalJSON(bz, &imported))
+	require.NoError(t, appB.ProfileKeeper.InitGenesis(ctxB, &imported))
+
+	storeA := ctxA.KVStore(appA.GetKey(profiletypes.StoreKey))
+	storeB := ctxB.KVStore(appB.GetKey(profiletypes.StoreKey))
+	failedKVAs, failedKVBs := simtestutil.DiffKVStores(storeA, storeB, nil)
+	require.Empty(t, failedKVAs, "store mismatch: %q", failedKVAs)
+	require.Empty(t, failedKVBs, "store mismatch: %q", failedKVBs)
+}
@@ -244,6 +244,32 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "QueryListPosts",
+					Use:       "list-posts [list_id] [page]",
+					Short:     "Query the latest posts of the members of a user list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "list_id",
+						},
+						{
+							ProtoField: "page",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryFollowSuggestions",
+					Use:       "follow-suggestions [viewer] [limit]",
+					Short:     "Suggest accounts for a viewer to follow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "viewer",
+						},
+						{
+							ProtoField: "limit",
+						},
+					},
+				},
 				{
 					RpcMethod: "QueryFollowingTopics",
 					Use:       "following-topics [address] [page]",
@@ -343,6 +369,55 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "image_id"},
 					},
 				},
+				{
+					RpcMethod: "QueryPostRevisions",
+					Use:       "post-revisions [post_id] [page]",
+					Short:     "Get the edit history of a post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "post_id",
+						},
+						{
+							ProtoField: "page",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryScheduledPosts",
+					Use:       "scheduled-posts [creator] [page]",
+					Short:     "Get the pending scheduled posts of a creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "page",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryReports",
+					Use:       "reports [page]",
+					Short:     "Get the moderation queue, reporters are left out",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "page",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"post_id": {},
+					},
+				},
+				{
+					RpcMethod: "QueryCommentThread",
+					Use:       "comment-thread [id]",
+					Short:     "Get the nested replies of a post or comment",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -691,6 +766,304 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "DeletePost",
+					Use:       "delete-post [creator] [post_id]",
+					Short:     "Delete post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "EditPost",
+					Use:       "edit-post [creator] [post_id] [content]",
+					Short:     "Edit post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+						{
+							ProtoField: "content",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"title":   {},
+						"mention": {},
+						"topic":   {},
+					},
+				},
+				{
+					RpcMethod: "CancelScheduledPost",
+					Use:       "cancel-scheduled-post [creator] [scheduled_id]",
+					Short:     "Cancel a pending scheduled post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "scheduled_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "UndoRepost",
+					Use:       "undo-repost [creator] [post_id]",
+					Short:     "Undo a repost",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "EditComment",
+					Use:       "edit-comment [creator] [comment_id] [comment]",
+					Short:     "Edit comment",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "comment_id",
+						},
+						{
+							ProtoField: "comment",
+						},
+					},
+				},
+				{
+					RpcMethod: "DeleteComment",
+					Use:       "delete-comment [creator] [comment_id]",
+					Short:     "Delete comment and its replies",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "comment_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "AddPostLabels",
+					Use:       "add-post-labels [creator] [post_id] [labels]",
+					Short:     "Add content labels to a post (admin)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+						{
+							ProtoField: "labels",
+							Varargs:    true,
+						},
+					},
+				},
+				{
+					RpcMethod: "ReportPost",
+					Use:       "report-post [creator] [post_id] [reason]",
+					Short:     "Report a post to the moderators",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+						{
+							ProtoField: "reason",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"note": {},
+					},
+				},
+				{
+					RpcMethod: "ClaimTopic",
+					Use:       "claim-topic [creator] [topic_id]",
+					Short:     "Become the admin of a topic you created",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "topic_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "SetTopicAdmin",
+					Use:       "set-topic-admin [creator] [topic_id] [admin]",
+					Short:     "Appoint the admin of a topic, leave admin empty to remove it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "topic_id",
+						},
+						{
+							ProtoField: "admin",
+						},
+					},
+				},
+				{
+					RpcMethod: "PinTopicPost",
+					Use:       "pin-topic-post [creator] [topic_id] [post_id]",
+					Short:     "Pin a post to the top of a topic (topic admins only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "topic_id",
+						},
+						{
+							ProtoField: "post_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "UnpinTopicPost",
+					Use:       "unpin-topic-post [creator] [topic_id] [post_id]",
+					Short:     "Unpin a post from a topic (topic admins only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "topic_id",
+						},
+						{
+							ProtoField: "post_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "RemoveTopicPost",
+					Use:       "remove-topic-post [creator] [topic_id] [post_id]",
+					Short:     "Remove a post from a topic (topic admins only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "topic_id",
+						},
+						{
+							ProtoField: "post_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "PinPost",
+					Use:       "pin-post [creator] [post_id]",
+					Short:     "Pin one of your posts to the top of your profile",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "UnpinPost",
+					Use:       "unpin-post [creator] [post_id]",
+					Short:     "Unpin a post from your profile",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "ResolveReport",
+					Use:       "resolve-report [creator] [post_id] [outcome]",
+					Short:     "Resolve the reports of a post (moderators only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+						{
+							ProtoField: "outcome",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"labels": {},
+					},
+				},
+				{
+					RpcMethod: "TakedownPost",
+					Use:       "takedown-post [creator] [post_id] [reason]",
+					Short:     "Take down a post (moderators only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+						{
+							ProtoField: "reason",
+						},
+					},
+				},
+				{
+					RpcMethod: "AppealTakedown",
+					Use:       "appeal-takedown [creator] [post_id] [appeal]",
+					Short:     "Appeal the takedown of your post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+						{
+							ProtoField: "appeal",
+						},
+					},
+				},
+				{
+					RpcMethod: "DecideAppeal",
+					Use:       "decide-appeal [creator] [post_id] [restore]",
+					Short:     "Restore a taken down post or reject its appeal (higher level admins only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "post_id",
+						},
+						{
+							ProtoField: "restore",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"note": {},
+					},
+				},
 				//{
 				//	RpcMethod: "Mention",
 				//	Use:       "mention [creator] [mention_json]",
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/tlock/x/post/types"
+	profiletypes "github.com/rollchains/tlock/x/profile/types"
+)
+
+// EndBlocker publishes the scheduled posts whose publish time has been reached,
+// finalizes the polls whose voting has ended and removes the comments of
+// deleted posts.
+func (k Keeper) EndBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms := msgServer{k: k}
+
+	ms.publishScheduledPosts(ctx)
+	ms.finalizePolls(ctx)
+	ms.notifyPollVoters(ctx, types.MaxPollNotificationsPerBlock)
+	k.PruneDuplicateIndex(ctx, ctx.BlockTime().Unix(), k.GetParams(ctx).DuplicateWindow, types.MaxDuplicatePrunesPerBlock)
+	ms.removeQueuedComments(ctx, types.MaxCommentsDeletedPerBlock)
+	return nil
+}
+
+// removeQueuedComments removes up to limit comments of the queued parents, the
+// replies of each removed comment are queued in turn.
+func (ms msgServer) removeQueuedComments(ctx sdk.Context, limit int) {
+	k := ms.k
+	parentIds, parentCreators := k.GetCommentDeleteQueue(ctx, limit)
+	for i, parentId := range parentIds {
+		commentIds := k.PopCommentIds(ctx, parentId, limit)
+		for _, commentId := range commentIds {
+			comment, found := k.GetPost(ctx, commentId)
+			if !found {
+				continue
+			}
+			if err := ms.removeComment(ctx, comment, parentCreators[i]); err != nil {
+				types.LogError(k.logger, "remove_queued_comment", err, "comment_id", commentId)
+			}
+		}
+		limit -= len(commentIds)
+		if limit <= 0 {
+			return
+		}
+		k.DeleteFromCommentDeleteQueue(ctx, parentId)
+	}
+}
+
+func (ms msgServer) publishScheduledPosts(ctx sdk.Context) {
+	k := ms.k
+	for _, scheduled := range k.GetDueScheduledPosts(ctx, ctx.BlockTime().Unix(), types.MaxScheduledPostsPerBlock) {
+		k.DeleteScheduledPost(ctx, scheduled)
+
+		// a post that fails to publish is dropped without undoing the others
+		cacheCtx, write := ctx.CacheContext()
+		postId, err := ms.createPost(cacheCtx, scheduled.Creator, scheduled.PostDetail, scheduled.TxHash)
+		if err != nil {
+			types.LogError(k.logger, "publish_scheduled_post", err, "scheduled_id", scheduled.Id, "creator", scheduled.Creator)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypePublishScheduledPost,
+				sdk.NewAttribute(types.AttributeKeyScheduledID, scheduled.Id),
+				sdk.NewAttribute(types.AttributeKeyCreator, scheduled.Creator),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePublishScheduledPost,
+			sdk.NewAttribute(types.AttributeKeyScheduledID, scheduled.Id),
+			sdk.NewAttribute(types.AttributeKeyCreator, scheduled.Creator),
+			sdk.NewAttribute(types.AttributeKeyPostID, postId),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", ctx.BlockTime().Unix())),
+		))
+	}
+}
+
+// finalizePolls recounts the ballots of every poll whose votingEnd has passed,
+// stores the final tally and notifies the poll creator and the voters.
+func (ms msgServer) finalizePolls(ctx sdk.Context) {
+	k := ms.k
+	postIds, votingEnds := k.GetClosedPolls(ctx, ctx.BlockTime().Unix(), types.MaxPollsClosedPerBlock)
+	for i, postId := range postIds {
+		k.DeletePollCloseQueue(ctx, votingEnds[i], postId)
+		post, found := k.GetPost(ctx, postId)
+		if !found || post.Poll == nil {
+			types.LogError(k.logger, "finalize_poll", types.ErrPostNotFound, "post_id", postId)
+			continue
+		}
+		if post.Poll.Finalized {
+			continue
+		}
+
+		voters, ballots := k.GetPollVotes(ctx, postId)
+		counts := make(map[int64]int64, len(post.Poll.Vote))
+		for _, optionIds := range ballots {
+			for _, optionId := range optionIds {
+				counts[optionId] += 1
+			}
+		}
+		tally := make([]string, 0, len(post.Poll.Vote))
+		for _, option := range post.Poll.Vote {
+			option.Count = counts[option.Id]
+			tally = append(tally, fmt.Sprintf("%d:%d", option.Id, option.Count))
+		}
+		post.Poll.TotalVotes = int64(len(voters))
+		post.Poll.Finalized = true
+		k.SetPost(ctx, post)
+
+		// the voters are notified by notifyPollVoters, a few per block
+		ms.addActivitiesReceived(ctx, post, "", "", post.Creator, post.Creator, profiletypes.ActivitiesType_ACTIVITIES_POLL_CLOSED)
+		if len(voters) > 0 {
+			k.SetPollNotifyQueue(ctx, postId, "")
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePollClosed,
+			sdk.NewAttribute(types.AttributeKeyPostID, postId),
+			sdk.NewAttribute(types.AttributeKeyCreator, post.Creator),
+			sdk.NewAttribute(types.AttributeKeyTotalVotes, fmt.Sprintf("%d", post.Poll.TotalVotes)),
+			sdk.NewAttribute(types.AttributeKeyTally, strings.Join(tally, ",")),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", ctx.BlockTime().Unix())),
+		))
+	}
+}
+
+// notifyPollVoters notifies up to limit voters of the finalized polls, a poll
+// with voters left over stays queued with the last voter notified.
+func (ms msgServer) notifyPollVoters(ctx sdk.Context, limit int) {
+	k := ms.k
+	postIds, lastVoters := k.GetPollNotifyQueue(ctx, limit)
+	for i, postId := range postIds {
+		post, found := k.GetPost(ctx, postId)
+		if !found {
+			k.DeletePollNotifyQueue(ctx, postId)
+			continue
+		}
+		voters := k.GetPollVoters(ctx, postId, lastVoters[i], limit)
+		for _, voter := range voters {
+			if voter != post.Creator {
+				ms.addActivitiesReceived(ctx, post, "", "", post.Creator, voter, profiletypes.ActivitiesType_ACTIVITIES_POLL_CLOSED)
+			}
+		}
+		limit -= len(voters)
+		if limit <= 0 {
+			k.SetPollNotifyQueue(ctx, postId, voters[len(voters)-1])
+			return
+		}
+		k.DeletePollNotifyQueue(ctx, postId)
+	}
+}
@@ -0,0 +1,511 @@
+package keeper
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/tlock/x/post/types"
+)
+
+// atTime returns a context whose block time is the given unix time, so that the
+// setters keyed by block time can rebuild the keys of exported records.
+func atTime(ctx sdk.Context, unix int64) sdk.Context {
+	return ctx.WithBlockTime(time.Unix(unix, 0))
+}
+
+// iterateStore walks every key under keyPrefix in ascending order.
+func (k Keeper) iterateStore(ctx sdk.Context, keyPrefix string, cb func(key, value []byte)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Key(), iterator.Value())
+	}
+}
+
+// sortedKeys keeps the counter writes of importState deterministic.
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// importState writes the records of a genesis state and rebuilds the feeds,
+// comment lists, reverse indexes and counters derived from them.
+func (k Keeper) importState(ctx sdk.Context, data *types.GenesisState) error {
+	// posts and comment lists
+	posts := make(map[string]types.Post, len(data.Posts))
+	for _, post := range data.Posts {
+		k.SetPost(ctx, post)
+		posts[post.Id] = post
+		if post.Poll != nil && !post.Poll.Finalized {
+			k.SetPollCloseQueue(ctx, post.Poll.VotingEnd, post.Id)
+		}
+	}
+	for _, post := range data.Posts {
+		if post.PostType != types.PostType_COMMENT {
+			continue
+		}
+		// taken down comments stay out of their parent's comment list
+		if post.TakedownStatus == types.TakedownStatus_TAKEDOWN_STATUS_NONE {
+			k.AddToCommentList(ctx, post.ParentId, post.Id, post.Score)
+		}
+		if parent, ok := posts[post.ParentId]; ok {
+			k.SetCommentsReceived(atTime(ctx, post.Timestamp), parent.Creator, post.Id)
+		} else {
+			// the parent was deleted, its comments are still queued for removal
+			k.AddToCommentDeleteQueue(ctx, post.ParentId, "")
+		}
+	}
+
+	// categories
+	for _, category := range data.Categories {
+		k.AddCategory(ctx, category)
+		k.AddCategoryWithIndex(ctx, category)
+	}
+	k.SetCategoryIndex(ctx, data.CategoryIndex)
+	if data.CategoryOperator != "" {
+		k.SetCategoryOperator(ctx, data.CategoryOperator)
+	}
+
+	// topics
+	topics := make(map[string]types.Topic, len(data.Topics))
+	var trendingKeywordsCount int64
+	for _, topic := range data.Topics {
+		k.AddTopic(ctx, topic)
+		k.SetTopicSearch(ctx, topic.Name)
+		if topic.TrendingKeywordsTime > 0 {
+			k.addToTrendingKeywords(ctx, topic.Id, topic.TrendingKeywordsScore)
+			trendingKeywordsCount++
+		}
+		topics[topic.Id] = topic
+	}
+	k.SetTrendingKeywordsCount(ctx, trendingKeywordsCount)
+	for _, image := range data.TopicImages {
+		k.SetTopicImage(ctx, image.TopicId, image.Image)
+	}
+	categoryTopicsCount := make(map[string]int64)
+	for _, mapping := range data.TopicCategories {
+		k.SetTopicCategoryMapping(ctx, mapping.TopicId, mapping.CategoryId)
+		k.SetCategoryTopics(ctx, mapping.CategoryId, mapping.TopicId, topics[mapping.TopicId].Score)
+		categoryTopicsCount[mapping.CategoryId]++
+	}
+	for _, categoryId := range sortedKeys(categoryTopicsCount) {
+		k.SetCategoryTopicsCount(ctx, categoryId, categoryTopicsCount[categoryId])
+	}
+	for _, topicId := range data.UncategorizedTopics {
+		k.SetUncategorizedTopics(atTime(ctx, topics[topicId].CreateTime), topicId)
+	}
+	k.SetUncategorizedTopicsCount(ctx, uint64(len(data.UncategorizedTopics)))
+	for _, topicId := range data.TrendingTopics {
+		k.addToTrendingTopics(ctx, topicId, topics[topicId].Score)
+	}
+	k.SetTrendingTopicsCount(ctx, int64(len(data.TrendingTopics)))
+
+	// post mappings
+	for _, mapping := range data.PostTopics {
+		k.SetPostTopicsMapping(ctx, mapping.TopicIds, mapping.PostId)
+	}
+	for _, mapping := range data.PostCategories {
+		k.SetPostCategoryMapping(ctx, mapping.CategoryId, mapping.PostId)
+	}
+
+	// feeds, keyed by the last time the post was bumped
+	for _, postId := range data.HomePosts {
+		k.SetHomePosts(atTime(ctx, posts[postId].HomePostsUpdate), postId)
+	}
+	k.SetHomePostsCount(ctx, int64(len(data.HomePosts)))
+	for _, feed := range data.TopicPosts {
+		for _, postId := range feed.PostIds {
+			k.SetTopicPosts(atTime(ctx, posts[postId].HomePostsUpdate), feed.Id, postId)
+		}
+		k.SetTopicPostsCount(ctx, feed.Id, int64(len(feed.PostIds)))
+	}
+	for _, feed := range data.CategoryPosts {
+		for _, postId := range feed.PostIds {
+			k.SetCategoryPosts(atTime(ctx, posts[postId].HomePostsUpdate), feed.Id, postId)
+		}
+		k.SetCategoryPostsCount(ctx, feed.Id, int64(len(feed.PostIds)))
+	}
+	userCreatedCount := make(map[string]int64)
+	for _, entry := range data.UserCreatedPosts {
+		k.AddToUserCreatedPosts(atTime(ctx, entry.Timestamp), entry.Address, entry.PostId)
+		userCreatedCount[entry.Address]++
+	}
+	for _, address := range sortedKeys(userCreatedCount) {
+		k.SetUserCreatedPostsCount(ctx, address, userCreatedCount[address])
+	}
+
+	// likes, saves, votes and follows
+	for _, like := range data.Likes {
+		likeCtx := atTime(ctx, like.Timestamp)
+		k.MarkUserLikedPost(likeCtx, like.Address, like.PostId)
+		k.SetLikesIMade(likeCtx, types.LikesIMade{PostId: like.PostId, Timestamp: like.Timestamp}, like.Address)
+		k.SetLikesReceived(likeCtx, types.LikesReceived{
+			LikerAddress: like.Address,
+			PostId:       like.PostId,
+			LikeType:     types.LikeType_LIKE,
+			Timestamp:    like.Timestamp,
+		}, posts[like.PostId].Creator)
+	}
+	for _, save := range data.Saves {
+		saveCtx := atTime(ctx, save.Timestamp)
+		k.MarkUserSavedPost(saveCtx, save.Address, save.PostId)
+		k.SetSavesIMade(saveCtx, types.LikesIMade{PostId: save.PostId, Timestamp: save.Timestamp}, save.Address)
+		k.SetLikesReceived(saveCtx, types.LikesReceived{
+			LikerAddress: save.Address,
+			PostId:       save.PostId,
+			LikeType:     types.LikeType_SAVE,
+			Timestamp:    save.Timestamp,
+		}, posts[save.PostId].Creator)
+	}
+	for _, vote := range data.PollVotes {
+		k.SetPoll(ctx, vote.PostId, vote.Voter, vote.OptionIds)
+	}
+	for _, follow := range data.TopicFollows {
+		followCtx := atTime(ctx, follow.Timestamp)
+		k.FollowTopic(followCtx, follow.Address, follow.TopicId)
+		k.SetFollowTopicTime(followCtx, follow.Address, follow.TopicId)
+	}
+
+	// images, tx hashes and revisions
+	for _, image := range data.PaidImages {
+		if err := k.SetPaidPostImage(ctx, image.ImageHash, image.Image); err != nil {
+			return types.WrapError(types.ErrDatabaseOperation, err.Error())
+		}
+	}
+	for _, mapping := range data.PostTxHashes {
+		k.SetPostTxHashMapping(ctx, mapping.PostId, mapping.TxHash)
+	}
+	revisionCount := make(map[string]int64)
+	for _, revision := range data.PostRevisions {
+		k.AddPostRevision(ctx, revision)
+		if int64(revision.Revision) > revisionCount[revision.PostId] {
+			revisionCount[revision.PostId] = int64(revision.Revision)
+		}
+	}
+	for _, postId := range sortedKeys(revisionCount) {
+		k.SetPostRevisionCount(ctx, postId, revisionCount[postId])
+	}
+	for _, repost := range data.Reposts {
+		k.SetRepost(ctx, repost)
+	}
+	for _, scheduled := range data.ScheduledPosts {
+		k.SetScheduledPost(ctx, scheduled)
+	}
+
+	// reports, the queue holds each post under its first report
+	reportCount := make(map[string]int64)
+	firstReport := make(map[string]int64)
+	for _, report := range data.Reports {
+		k.SetReport(ctx, report)
+		if first, ok := firstReport[report.PostId]; !ok || report.Timestamp < first {
+			firstReport[report.PostId] = report.Timestamp
+		}
+		reportCount[report.PostId]++
+	}
+	for _, postId := range sortedKeys(reportCount) {
+		k.SetReportCount(ctx, postId, uint64(reportCount[postId]))
+		k.AddToReportQueue(ctx, firstReport[postId], postId)
+	}
+	for _, pin := range data.TopicPins {
+		k.SetTopicPin(ctx, pin.TopicId, pin.PostId, pin.Timestamp)
+	}
+	for _, pin := range data.UserPins {
+		k.SetUserPin(ctx, pin.Address, pin.PostId, pin.Timestamp)
+	}
+
+	// duplicate content index, every entry is queued for pruning at the end of its window
+	window := k.GetParams(ctx).DuplicateWindow
+	for _, content := range data.CreatorContents {
+		k.SetCreatorContentTime(ctx, content.Creator, content.ContentHash, content.Timestamp)
+		k.AddToDuplicateQueue(ctx, content.Timestamp+window, types.DuplicateUserKeyPrefix+content.Creator+"/"+content.ContentHash)
+	}
+	for _, content := range data.ContentRepetitions {
+		k.SetContentRepetition(ctx, content.ContentHash, content.Repetition)
+		k.AddToDuplicateQueue(ctx, content.Repetition.WindowStart+window, types.DuplicateGlobalKeyPrefix+content.ContentHash)
+	}
+	return nil
+}
+
+// exportState reads every post module record into the genesis state. Comments
+// left behind by a deleted parent and index entries pointing to missing posts,
+// topics or categories are dropped so that the export always validates.
+func (k Keeper) exportState(ctx sdk.Context, genesis *types.GenesisState) {
+	// posts
+	all := make(map[string]types.Post)
+	var ids []string
+	k.iterateStore(ctx, types.PostKeyPrefix, func(key, value []byte) {
+		var post types.Post
+		if err := k.cdc.Unmarshal(value, &post); err != nil {
+			types.LogError(k.logger, "export_post", err, "post_id", string(key))
+			return
+		}
+		all[post.Id] = post
+		ids = append(ids, post.Id)
+	})
+	posts := make(map[string]bool, len(all))
+	var isLive func(id string, depth int) bool
+	isLive = func(id string, depth int) bool {
+		post, ok := all[id]
+		if !ok || depth > len(all) {
+			return false
+		}
+		if post.PostType != types.PostType_COMMENT {
+			return true
+		}
+		return isLive(post.ParentId, depth+1)
+	}
+	for _, id := range ids {
+		if isLive(id, 0) {
+			posts[id] = true
+			genesis.Posts = append(genesis.Posts, all[id])
+		}
+	}
+
+	// categories, skipping the index/topics/mapping/operator entries sharing the prefix
+	categories := make(map[string]bool)
+	k.iterateStore(ctx, types.CategoryKeyPrefix, func(key, value []byte) {
+		if strings.Contains(string(key), "/") {
+			return
+		}
+		var category types.Category
+		if err := k.cdc.Unmarshal(value, &category); err != nil || category.Id != string(key) {
+			return
+		}
+		categories[category.Id] = true
+		genesis.Categories = append(genesis.Categories, category)
+	})
+	genesis.CategoryIndex, _ = k.GetCategoryIndex(ctx)
+	genesis.CategoryOperator, _ = k.GetCategoryOperator(ctx)
+
+	// topics, skipping the image/search sub-stores sharing the prefix
+	topics := make(map[string]bool)
+	k.iterateStore(ctx, types.TopicKeyPrefix, func(key, value []byte) {
+		if strings.Contains(string(key), "/") {
+			return
+		}
+		var topic types.Topic
+		if err := k.cdc.Unmarshal(value, &topic); err != nil || topic.Id != string(key) {
+			return
+		}
+		topics[topic.Id] = true
+		genesis.Topics = append(genesis.Topics, topic)
+	})
+	k.iterateStore(ctx, types.TopicImagePrefix, func(key, value []byte) {
+		if topics[string(key)] {
+			genesis.TopicImages = append(genesis.TopicImages, types.GenesisTopicImage{TopicId: string(key), Image: string(value)})
+		}
+	})
+	k.iterateStore(ctx, types.TopicCategoryMappingKeyPrefix, func(key, value []byte) {
+		if topics[string(key)] && categories[string(value)] {
+			genesis.TopicCategories = append(genesis.TopicCategories, types.GenesisTopicCategory{TopicId: string(key), CategoryId: string(value)})
+		}
+	})
+	uncategorized := make(map[string]bool)
+	k.iterateStore(ctx, types.UncategorizedTopicsKeyPrefix, func(_, value []byte) {
+		topicId := string(value)
+		if topics[topicId] && !uncategorized[topicId] {
+			uncategorized[topicId] = true
+			genesis.UncategorizedTopics = append(genesis.UncategorizedTopics, topicId)
+		}
+	})
+	k.iterateStore(ctx, types.TrendingTopicsPrefix, func(_, value []byte) {
+		if topics[string(value)] {
+			genesis.TrendingTopics = append(genesis.TrendingTopics, string(value))
+		}
+	})
+
+	// post mappings
+	k.iterateStore(ctx, types.PostTopicsMappingKeyPrefix, func(key, value []byte) {
+		if !posts[string(key)] {
+			return
+		}
+		var topicIds []string
+		if err := json.Unmarshal(value, &topicIds); err != nil {
+			types.LogError(k.logger, "export_post_topics", err, "post_id", string(key))
+			return
+		}
+		var live []string
+		for _, topicId := range topicIds {
+			if topics[topicId] {
+				live = append(live, topicId)
+			}
+		}
+		if len(live) > 0 {
+			genesis.PostTopics = append(genesis.PostTopics, types.GenesisPostTopics{PostId: string(key), TopicIds: live})
+		}
+	})
+	k.iterateStore(ctx, types.PostCategoryMappingKeyPrefix, func(key, value []byte) {
+		if posts[string(key)] && categories[string(value)] {
+			genesis.PostCategories = append(genesis.PostCategories, types.GenesisPostCategory{PostId: string(key), CategoryId: string(value)})
+		}
+	})
+
+	// feeds
+	k.iterateStore(ctx, types.HomePostsKeyPrefix, func(_, value []byte) {
+		if posts[string(value)] {
+			genesis.HomePosts = append(genesis.HomePosts, string(value))
+		}
+	})
+	for _, topic := range genesis.Topics {
+		feed := types.GenesisFeed{Id: topic.Id}
+		k.iterateStore(ctx, types.TopicPostsKeyPrefix+topic.Id, func(_, value []byte) {
+			if posts[string(value)] {
+				feed.PostIds = append(feed.PostIds, string(value))
+			}
+		})
+		if len(feed.PostIds) > 0 {
+			genesis.TopicPosts = append(genesis.TopicPosts, feed)
+		}
+	}
+	for _, category := range genesis.Categories {
+		feed := types.GenesisFeed{Id: category.Id}
+		k.iterateStore(ctx, types.CategoryPostsKeyPrefix+category.Id, func(_, value []byte) {
+			if posts[string(value)] {
+				feed.PostIds = append(feed.PostIds, string(value))
+			}
+		})
+		if len(feed.PostIds) > 0 {
+			genesis.CategoryPosts = append(genesis.CategoryPosts, feed)
+		}
+	}
+	var creators []string
+	k.iterateStore(ctx, types.UserCreatedPostsCountKeyPrefix, func(key, _ []byte) {
+		creators = append(creators, string(key))
+	})
+	for _, creator := range creators {
+		k.iterateStore(ctx, types.UserCreatedPostsKeyPrefix+creator, func(key, value []byte) {
+			if len(key) < 8 || !posts[string(value)] {
+				return
+			}
+			genesis.UserCreatedPosts = append(genesis.UserCreatedPosts, types.GenesisUserCreatedPost{
+				Address:   creator,
+				PostId:    string(value),
+				Timestamp: btoi(key[:8]),
+			})
+		})
+	}
+
+	// likes, saves, votes and follows
+	genesis.Likes = k.exportReactions(ctx, types.UserLikesPrefix, posts)
+	genesis.Saves = k.exportReactions(ctx, types.UserSavesPrefix, posts)
+	k.iterateStore(ctx, types.PollUserPrefix, func(key, value []byte) {
+		postId, voter, ok := strings.Cut(string(key), "/")
+		if ok && posts[postId] {
+			genesis.PollVotes = append(genesis.PollVotes, types.GenesisPollVote{PostId: postId, Voter: voter, OptionIds: decodePollOptions(value)})
+		}
+	})
+	k.iterateStore(ctx, types.FollowTopicTimePrefix, func(key, value []byte) {
+		address, topicId, ok := strings.Cut(string(key), ":")
+		if ok && topics[topicId] {
+			genesis.TopicFollows = append(genesis.TopicFollows, types.GenesisTopicFollow{Address: address, TopicId: topicId, Timestamp: btoi(value)})
+		}
+	})
+
+	// images, tx hashes and revisions
+	k.iterateStore(ctx, types.PostPaidImagePrefix, func(key, value []byte) {
+		var image string
+		if err := json.Unmarshal(value, &image); err != nil {
+			types.LogError(k.logger, "export_paid_image", err, "image_hash", string(key))
+			return
+		}
+		genesis.PaidImages = append(genesis.PaidImages, types.GenesisPaidImage{ImageHash: string(key), Image: image})
+	})
+	k.iterateStore(ctx, types.PostTxHashMappingKeyPrefix, func(key, value []byte) {
+		if posts[string(key)] {
+			genesis.PostTxHashes = append(genesis.PostTxHashes, types.GenesisPostTxHash{PostId: string(key), TxHash: string(value)})
+		}
+	})
+	k.iterateStore(ctx, types.PostRevisionKeyPrefix, func(key, value []byte) {
+		var revision types.PostRevision
+		if err := k.cdc.Unmarshal(value, &revision); err != nil {
+			types.LogError(k.logger, "export_post_revision", err, "key", string(key))
+			return
+		}
+		if posts[revision.PostId] {
+			genesis.PostRevisions = append(genesis.PostRevisions, revision)
+		}
+	})
+	k.iterateStore(ctx, types.RepostKeyPrefix, func(key, value []byte) {
+		var repost types.Repost
+		if err := k.cdc.Unmarshal(value, &repost); err != nil {
+			types.LogError(k.logger, "export_repost", err, "key", string(key))
+			return
+		}
+		if posts[repost.PostId] {
+			genesis.Reposts = append(genesis.Reposts, repost)
+		}
+	})
+	k.iterateStore(ctx, types.ScheduledPostKeyPrefix, func(key, value []byte) {
+		var scheduled types.ScheduledPost
+		if err := k.cdc.Unmarshal(value, &scheduled); err != nil {
+			types.LogError(k.logger, "export_scheduled_post", err, "scheduled_id", string(key))
+			return
+		}
+		genesis.ScheduledPosts = append(genesis.ScheduledPosts, scheduled)
+	})
+	k.iterateStore(ctx, types.PostReportKeyPrefix, func(key, value []byte) {
+		var report types.Report
+		if err := k.cdc.Unmarshal(value, &report); err != nil {
+			types.LogError(k.logger, "export_report", err, "key", string(key))
+			return
+		}
+		if posts[report.PostId] {
+			genesis.Reports = append(genesis.Reports, report)
+		}
+	})
+	k.iterateStore(ctx, types.TopicPinnedPostsKeyPrefix, func(key, value []byte) {
+		topicId, postId, ok := strings.Cut(string(key), "/")
+		if ok && topics[topicId] && posts[postId] {
+			genesis.TopicPins = append(genesis.TopicPins, types.GenesisTopicPin{TopicId: topicId, PostId: postId, Timestamp: btoi(value)})
+		}
+	})
+	k.iterateStore(ctx, types.UserPinnedPostsKeyPrefix, func(key, value []byte) {
+		address, postId, ok := strings.Cut(string(key), "/")
+		if ok && posts[postId] {
+			genesis.UserPins = append(genesis.UserPins, types.GenesisUserPin{Address: address, PostId: postId, Timestamp: btoi(value)})
+		}
+	})
+
+	// duplicate content index
+	k.iterateStore(ctx, types.DuplicateUserKeyPrefix, func(key, value []byte) {
+		creator, contentHash, ok := strings.Cut(string(key), "/")
+		if ok {
+			genesis.CreatorContents = append(genesis.CreatorContents, types.GenesisCreatorContent{Creator: creator, ContentHash: contentHash, Timestamp: btoi(value)})
+		}
+	})
+	k.iterateStore(ctx, types.DuplicateGlobalKeyPrefix, func(key, value []byte) {
+		var repetition types.ContentRepetition
+		if err := k.cdc.Unmarshal(value, &repetition); err != nil {
+			types.LogError(k.logger, "export_content_repetition", err, "content_hash", string(key))
+			return
+		}
+		genesis.ContentRepetitions = append(genesis.ContentRepetitions, types.GenesisContentRepetition{ContentHash: string(key), Repetition: repetition})
+	})
+}
+
+// exportReactions reads the address/postId marks written by MarkUserLikedPost
+// and MarkUserSavedPost, whose value is the time of the like or save.
+func (k Keeper) exportReactions(ctx sdk.Context, keyPrefix string, posts map[string]bool) []types.GenesisReaction {
+	var reactions []types.GenesisReaction
+	k.iterateStore(ctx, keyPrefix, func(key, value []byte) {
+		address, postId, ok := strings.Cut(string(key), "/")
+		if ok && posts[postId] {
+			reactions = append(reactions, types.GenesisReaction{Address: address, PostId: postId, Timestamp: btoi(value)})
+		}
+	})
+	// LikesReceived is keyed by time only, replay in time order so the latest one wins
+	sort.SliceStable(reactions, func(i, j int) bool {
+		return reactions[i].Timestamp < reactions[j].Timestamp
+	})
+	return reactions
+}
@@ -20,3 +20,71 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := SetupTest(t)
+	alice := f.addrs[0].String()
+	bob := f.addrs[1].String()
+
+	genesisState := &types.GenesisState{
+		Params: types.DefaultParams(),
+		Posts: []types.Post{
+			{Id: "post1", Creator: alice, Timestamp: 100, HomePostsUpdate: 200, CommentCount: 1, LikeCount: 1},
+			{Id: "comment1", PostType: types.PostType_COMMENT, ParentId: "post1", Creator: bob, Timestamp: 200},
+		},
+		Categories:      []types.Category{{Id: "category1", Name: "news", Index: 1}},
+		CategoryIndex:   1,
+		Topics:          []types.Topic{{Id: "topic1", Name: "chain", CreateTime: 100}},
+		TopicCategories: []types.GenesisTopicCategory{{TopicId: "topic1", CategoryId: "category1"}},
+		PostTopics:      []types.GenesisPostTopics{{PostId: "post1", TopicIds: []string{"topic1"}}},
+		PostCategories:  []types.GenesisPostCategory{{PostId: "post1", CategoryId: "category1"}},
+		HomePosts:       []string{"post1"},
+		TopicPosts:      []types.GenesisFeed{{Id: "topic1", PostIds: []string{"post1"}}},
+		CategoryPosts:   []types.GenesisFeed{{Id: "category1", PostIds: []string{"post1"}}},
+		UserCreatedPosts: []types.GenesisUserCreatedPost{
+			{Address: alice, PostId: "post1", Timestamp: 100},
+		},
+		Likes:        []types.GenesisReaction{{Address: bob, PostId: "post1", Timestamp: 150}},
+		TopicFollows: []types.GenesisTopicFollow{{Address: bob, TopicId: "topic1", Timestamp: 120}},
+		CreatorContents: []types.GenesisCreatorContent{
+			{Creator: alice, ContentHash: "hash1", Timestamp: 100},
+		},
+		ContentRepetitions: []types.GenesisContentRepetition{
+			{ContentHash: "hash1", Repetition: types.ContentRepetition{WindowStart: 100, Count: 1}},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+	require.NoError(t, f.k.InitGenesis(f.ctx, genesisState))
+
+	// the secondary indexes are rebuilt
+	require.True(t, f.k.IsPostInHomePosts(f.ctx, "post1", 200))
+	require.True(t, f.k.IsPostInTopicPosts(f.ctx, "topic1", "post1", 200))
+	require.True(t, f.k.IsPostInCategoryPosts(f.ctx, "category1", "post1", 200))
+	require.True(t, f.k.HasUserLikedPost(f.ctx, bob, "post1"))
+	require.True(t, f.k.IsFollowingTopic(f.ctx, bob, "topic1"))
+	comments, _, _, err := f.k.GetCommentsByParentId(f.ctx, "post1", 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"comment1"}, comments)
+	received, _, _, err := f.k.GetCommentsReceived(f.ctx, alice, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"comment1"}, received)
+	count, _ := f.k.GetHomePostsCount(f.ctx)
+	require.Equal(t, int64(1), count)
+
+	got := f.k.ExportGenesis(f.ctx)
+	require.NoError(t, got.Validate())
+	require.ElementsMatch(t, genesisState.Posts, got.Posts)
+	require.Equal(t, genesisState.Categories, got.Categories)
+	require.Equal(t, genesisState.Topics, got.Topics)
+	require.Equal(t, genesisState.TopicCategories, got.TopicCategories)
+	require.Equal(t, genesisState.PostTopics, got.PostTopics)
+	require.Equal(t, genesisState.PostCategories, got.PostCategories)
+	require.Equal(t, genesisState.HomePosts, got.HomePosts)
+	require.Equal(t, genesisState.TopicPosts, got.TopicPosts)
+	require.Equal(t, genesisState.CategoryPosts, got.CategoryPosts)
+	require.Equal(t, genesisState.UserCreatedPosts, got.UserCreatedPosts)
+	require.Equal(t, genesisState.Likes, got.Likes)
+	require.Equal(t, genesisState.TopicFollows, got.TopicFollows)
+	require.Equal(t, genesisState.CreatorContents, got.CreatorContents)
+	require.Equal(t, genesisState.ContentRepetitions, got.ContentRepetitions)
+}
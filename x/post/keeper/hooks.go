@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	profiletypes "github.com/rollchains/tlock/x/profile/types"
+)
+
+// Hooks wraps the keeper to implement profiletypes.ProfileHooks.
+type Hooks struct {
+	k Keeper
+}
+
+var _ profiletypes.ProfileHooks = Hooks{}
+
+// Hooks returns the profile hooks of the post module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterPrivacyChanged moves the posts of address in or out of the public feeds.
+func (h Hooks) AfterPrivacyChanged(ctx sdk.Context, address string, _ bool) {
+	msgServer{k: h.k}.reindexCreatorPosts(ctx, address)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	profileKeeper "github.com/rollchains/tlock/x/profile/keeper"
+	profiletypes "github.com/rollchains/tlock/x/profile/types"
 
 	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/core/store"
@@ -125,7 +127,11 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		return err
 	}
 
-	return k.Params.Set(ctx, data.Params)
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	return k.importState(sdk.UnwrapSDKContext(ctx), data)
 }
 
 // ExportGenesis exports the module's state to a genesis state.
@@ -135,9 +141,11 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return &types.GenesisState{
+	genesis := &types.GenesisState{
 		Params: params,
 	}
+	k.exportState(sdk.UnwrapSDKContext(ctx), genesis)
+	return genesis
 }
 
 func (k Keeper) EncodeBlockTime(ctx sdk.Context) []byte {
@@ -362,6 +370,10 @@ func (k Keeper) IsPostInTopics(ctx sdk.Context, postId string) bool {
 	key := append([]byte(postId))
 	return store.Has(key)
 }
+func (k Keeper) DeletePostTopicsMapping(ctx sdk.Context, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostTopicsMappingKeyPrefix))
+	store.Delete([]byte(postId))
+}
 
 func (k Keeper) SetTopicSearch(ctx sdk.Context, topic string) {
 	topicLower := strings.ToLower(topic)
@@ -456,6 +468,11 @@ func (k Keeper) DeleteFromTopicPostsByTopicAndPostId(ctx sdk.Context, topic stri
 	key := append(bzBlockTime, []byte(postId)...)
 	store.Delete(key)
 }
+func (k Keeper) IsPostInTopicPosts(ctx sdk.Context, topic string, postId string, homePostsUpdate int64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TopicPostsKeyPrefix+topic))
+	key := append(itob(homePostsUpdate), []byte(postId)...)
+	return store.Has(key)
+}
 func (k Keeper) DeleteLastPostFromTopicPosts(ctx sdk.Context, topic string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TopicPostsKeyPrefix+topic))
 	iterator := store.Iterator(nil, nil)
@@ -531,6 +548,419 @@ func (k Keeper) DeleteLastPostFromUserCreated(ctx sdk.Context, creator string) {
 	k.logger.Info("Deleted earliest user created post", "post_id", earliestPostID)
 }
 
+// DeleteFromUserCreatedPostsByPostId removes every entry of postId from the creator's list
+// and returns the number of entries removed.
+func (k Keeper) DeleteFromUserCreatedPostsByPostId(ctx sdk.Context, creator string, postId string) int64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserCreatedPostsKeyPrefix+creator))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if string(iterator.Value()) == postId {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return int64(len(keys))
+}
+
+// DeleteFromUserCreatedPosts removes the entry of postId added to the creator's list at timestamp.
+func (k Keeper) DeleteFromUserCreatedPosts(ctx sdk.Context, creator string, postId string, timestamp int64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserCreatedPostsKeyPrefix+creator))
+	key := append(itob(timestamp), []byte(postId)...)
+	if !store.Has(key) {
+		return false
+	}
+	store.Delete(key)
+	return true
+}
+
+// SetRepost records a repost under the reposter and under the reposted post.
+func (k Keeper) SetRepost(ctx sdk.Context, repost types.Repost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RepostKeyPrefix+repost.Reposter+"/"))
+	store.Set([]byte(repost.PostId), k.cdc.MustMarshal(&repost))
+
+	postStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRepostsKeyPrefix+repost.PostId+"/"))
+	postStore.Set([]byte(repost.Reposter), []byte(repost.Reposter))
+}
+
+// GetRepost returns the repost of postId made by reposter.
+func (k Keeper) GetRepost(ctx sdk.Context, reposter string, postId string) (types.Repost, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RepostKeyPrefix+reposter+"/"))
+	var repost types.Repost
+	bz := store.Get([]byte(postId))
+	if bz == nil {
+		return repost, false
+	}
+	if err := k.cdc.Unmarshal(bz, &repost); err != nil {
+		types.LogError(k.logger, "unmarshal_repost", err, "reposter", reposter, "post_id", postId)
+		return repost, false
+	}
+	return repost, true
+}
+
+// DeleteRepost removes both records of a repost.
+func (k Keeper) DeleteRepost(ctx sdk.Context, reposter string, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RepostKeyPrefix+reposter+"/"))
+	store.Delete([]byte(postId))
+
+	postStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRepostsKeyPrefix+postId+"/"))
+	postStore.Delete([]byte(reposter))
+}
+
+// GetRepostersByPostId returns the address of every user who reposted postId.
+func (k Keeper) GetRepostersByPostId(ctx sdk.Context, postId string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRepostsKeyPrefix+postId+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var reposters []string
+	for ; iterator.Valid(); iterator.Next() {
+		reposters = append(reposters, string(iterator.Value()))
+	}
+	return reposters
+}
+
+// SetScheduledPost stores a scheduled post and queues it under its publish time.
+func (k Keeper) SetScheduledPost(ctx sdk.Context, scheduled types.ScheduledPost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ScheduledPostKeyPrefix))
+	store.Set([]byte(scheduled.Id), k.cdc.MustMarshal(&scheduled))
+
+	key := append(itob(scheduled.PublishAt), []byte(scheduled.Id)...)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ScheduledPostQueueKeyPrefix))
+	queueStore.Set(key, []byte(scheduled.Id))
+	userStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserScheduledPostsKeyPrefix+scheduled.Creator+"/"))
+	userStore.Set(key, []byte(scheduled.Id))
+}
+
+// GetScheduledPost returns a pending scheduled post.
+func (k Keeper) GetScheduledPost(ctx sdk.Context, id string) (types.ScheduledPost, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ScheduledPostKeyPrefix))
+	var scheduled types.ScheduledPost
+	bz := store.Get([]byte(id))
+	if bz == nil {
+		return scheduled, false
+	}
+	if err := k.cdc.Unmarshal(bz, &scheduled); err != nil {
+		types.LogError(k.logger, "unmarshal_scheduled_post", err, "scheduled_id", id)
+		return scheduled, false
+	}
+	return scheduled, true
+}
+
+// DeleteScheduledPost removes a scheduled post from the store, the queue and the creator's list.
+func (k Keeper) DeleteScheduledPost(ctx sdk.Context, scheduled types.ScheduledPost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ScheduledPostKeyPrefix))
+	store.Delete([]byte(scheduled.Id))
+
+	key := append(itob(scheduled.PublishAt), []byte(scheduled.Id)...)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ScheduledPostQueueKeyPrefix))
+	queueStore.Delete(key)
+	userStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserScheduledPostsKeyPrefix+scheduled.Creator+"/"))
+	userStore.Delete(key)
+}
+
+// GetDueScheduledPosts returns, in publish order, up to limit scheduled posts
+// whose publish time is not after blockTime.
+func (k Keeper) GetDueScheduledPosts(ctx sdk.Context, blockTime int64, limit int) []types.ScheduledPost {
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ScheduledPostQueueKeyPrefix))
+	iterator := queueStore.Iterator(nil, itob(blockTime+1))
+	defer iterator.Close()
+
+	var due []types.ScheduledPost
+	for ; iterator.Valid() && len(due) < limit; iterator.Next() {
+		scheduled, found := k.GetScheduledPost(ctx, string(iterator.Value()))
+		if !found {
+			types.LogError(k.logger, "get_due_scheduled_posts", types.ErrResourceNotFound, "scheduled_id", string(iterator.Value()))
+			continue
+		}
+		due = append(due, scheduled)
+	}
+	return due
+}
+
+// GetScheduledPostsByCreator returns a page of the creator's pending scheduled posts, soonest first.
+func (k Keeper) GetScheduledPostsByCreator(ctx sdk.Context, creator string, page uint64) ([]types.ScheduledPost, *query.PageResponse, uint64, error) {
+	if page < 1 {
+		page = 1
+	}
+	pageRequest := &query.PageRequest{
+		Offset: (page - 1) * types.ScheduledPostsPageSize,
+		Limit:  types.ScheduledPostsPageSize,
+	}
+	userStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserScheduledPostsKeyPrefix+creator+"/"))
+
+	var scheduledPosts []types.ScheduledPost
+	pageResponse, err := query.Paginate(userStore, pageRequest, func(key []byte, value []byte) error {
+		if scheduled, found := k.GetScheduledPost(ctx, string(value)); found {
+			scheduledPosts = append(scheduledPosts, scheduled)
+		}
+		return nil
+	})
+	if err != nil {
+		types.LogError(k.logger, "get_scheduled_posts_by_creator", err, "creator", creator, "page", page)
+		return nil, nil, uint64(0), types.WrapError(types.ErrDatabaseOperation, "failed to paginate scheduled posts")
+	}
+	return scheduledPosts, pageResponse, page, nil
+}
+
+// IsModerator reports whether address can act on reported content.
+func (k Keeper) IsModerator(ctx sdk.Context, address string) bool {
+	return k.ProfileKeeper.HasPermission(ctx, address, profiletypes.Permission_PERMISSION_MODERATE_POSTS)
+}
+
+// SetReport stores a user's report of a post.
+func (k Keeper) SetReport(ctx sdk.Context, report types.Report) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostReportKeyPrefix+report.PostId+"/"))
+	store.Set([]byte(report.Reporter), k.cdc.MustMarshal(&report))
+}
+
+// HasReport reports whether reporter has an open report on the post.
+func (k Keeper) HasReport(ctx sdk.Context, postId string, reporter string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostReportKeyPrefix+postId+"/"))
+	return store.Has([]byte(reporter))
+}
+
+// GetReportsByPostId returns the open reports of a post.
+func (k Keeper) GetReportsByPostId(ctx sdk.Context, postId string) []types.Report {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostReportKeyPrefix+postId+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var reports []types.Report
+	for ; iterator.Valid(); iterator.Next() {
+		var report types.Report
+		if err := k.cdc.Unmarshal(iterator.Value(), &report); err != nil {
+			types.LogError(k.logger, "unmarshal_report", err, "post_id", postId)
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+func (k Keeper) SetReportCount(ctx sdk.Context, postId string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostReportCountKeyPrefix))
+	store.Set([]byte(postId), itob(int64(count)))
+}
+
+// GetReportCount returns the number of open reports of a post.
+func (k Keeper) GetReportCount(ctx sdk.Context, postId string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostReportCountKeyPrefix))
+	return uint64(btoi(store.Get([]byte(postId))))
+}
+
+// AddToReportQueue queues a reported post under the time of its first report.
+func (k Keeper) AddToReportQueue(ctx sdk.Context, timestamp int64, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReportQueueKeyPrefix))
+	store.Set(append(itob(timestamp), []byte(postId)...), []byte(postId))
+}
+
+// GetReportQueue returns a page of the reported posts, oldest first.
+func (k Keeper) GetReportQueue(ctx sdk.Context, page uint64) ([]string, *query.PageResponse, uint64, error) {
+	if page < 1 {
+		page = 1
+	}
+	pageRequest := &query.PageRequest{
+		Offset: (page - 1) * types.ReportsPageSize,
+		Limit:  types.ReportsPageSize,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReportQueueKeyPrefix))
+
+	var postIds []string
+	pageResponse, err := query.Paginate(store, pageRequest, func(key []byte, value []byte) error {
+		postIds = append(postIds, string(value))
+		return nil
+	})
+	if err != nil {
+		types.LogError(k.logger, "get_report_queue", err, "page", page)
+		return nil, nil, uint64(0), types.WrapError(types.ErrDatabaseOperation, "failed to paginate reports")
+	}
+	return postIds, pageResponse, page, nil
+}
+
+// DeletePostReports closes every open report of a post and takes it off the queue.
+func (k Keeper) DeletePostReports(ctx sdk.Context, postId string) {
+	reports := k.GetReportsByPostId(ctx, postId)
+	if len(reports) == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostReportKeyPrefix+postId+"/"))
+	first := reports[0].Timestamp
+	for _, report := range reports {
+		store.Delete([]byte(report.Reporter))
+		if report.Timestamp < first {
+			first = report.Timestamp
+		}
+	}
+	countStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostReportCountKeyPrefix))
+	countStore.Delete([]byte(postId))
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReportQueueKeyPrefix))
+	queueStore.Delete(append(itob(first), []byte(postId)...))
+}
+
+// SetTopicPin pins a post to the top of a topic feed.
+func (k Keeper) SetTopicPin(ctx sdk.Context, topicHash string, postId string, timestamp int64) {
+	k.setPin(ctx, types.TopicPinnedPostsKeyPrefix, topicHash, postId, timestamp)
+}
+
+// DeleteTopicPin unpins a post from a topic feed.
+func (k Keeper) DeleteTopicPin(ctx sdk.Context, topicHash string, postId string) {
+	k.deletePin(ctx, types.TopicPinnedPostsKeyPrefix, topicHash, postId)
+}
+
+// IsTopicPin reports whether the post is pinned to the topic feed.
+func (k Keeper) IsTopicPin(ctx sdk.Context, topicHash string, postId string) bool {
+	return k.isPin(ctx, types.TopicPinnedPostsKeyPrefix, topicHash, postId)
+}
+
+// GetTopicPins returns the posts pinned to a topic feed, the latest pin first.
+func (k Keeper) GetTopicPins(ctx sdk.Context, topicHash string) []string {
+	return k.getPins(ctx, types.TopicPinnedPostsKeyPrefix, topicHash)
+}
+
+// SetUserPin pins a post to the top of its creator's profile.
+func (k Keeper) SetUserPin(ctx sdk.Context, address string, postId string, timestamp int64) {
+	k.setPin(ctx, types.UserPinnedPostsKeyPrefix, address, postId, timestamp)
+}
+
+// DeleteUserPin unpins a post from the profile of address.
+func (k Keeper) DeleteUserPin(ctx sdk.Context, address string, postId string) {
+	k.deletePin(ctx, types.UserPinnedPostsKeyPrefix, address, postId)
+}
+
+// IsUserPin reports whether the post is pinned to the profile of address.
+func (k Keeper) IsUserPin(ctx sdk.Context, address string, postId string) bool {
+	return k.isPin(ctx, types.UserPinnedPostsKeyPrefix, address, postId)
+}
+
+// GetUserPins returns the posts pinned to the profile of address, the latest pin first.
+func (k Keeper) GetUserPins(ctx sdk.Context, address string) []string {
+	return k.getPins(ctx, types.UserPinnedPostsKeyPrefix, address)
+}
+
+// GetParams returns the module params.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params, _ := k.Params.Get(ctx)
+	return params
+}
+
+// GetMaxPinnedPosts returns how many posts a user can pin to their profile.
+func (k Keeper) GetMaxPinnedPosts(ctx sdk.Context) int {
+	params, err := k.Params.Get(ctx)
+	if err != nil || params.MaxPinnedPosts == 0 {
+		return types.DefaultMaxPinnedPosts
+	}
+	return int(params.MaxPinnedPosts)
+}
+
+// pins are stored under keyPrefix + owner + "/" + postId with the pin time as value
+func (k Keeper) setPin(ctx sdk.Context, keyPrefix string, owner string, postId string, timestamp int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix+owner+"/"))
+	store.Set([]byte(postId), itob(timestamp))
+}
+
+func (k Keeper) deletePin(ctx sdk.Context, keyPrefix string, owner string, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix+owner+"/"))
+	store.Delete([]byte(postId))
+}
+
+func (k Keeper) isPin(ctx sdk.Context, keyPrefix string, owner string, postId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix+owner+"/"))
+	return store.Has([]byte(postId))
+}
+
+func (k Keeper) getPins(ctx sdk.Context, keyPrefix string, owner string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix+owner+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var postIds []string
+	pinnedAt := make(map[string]int64)
+	for ; iterator.Valid(); iterator.Next() {
+		postId := string(iterator.Key())
+		postIds = append(postIds, postId)
+		pinnedAt[postId] = btoi(iterator.Value())
+	}
+	sort.SliceStable(postIds, func(i, j int) bool {
+		return pinnedAt[postIds[i]] > pinnedAt[postIds[j]]
+	})
+	return postIds
+}
+
+// GetCreatorContentTime returns when creator last posted the content hash.
+func (k Keeper) GetCreatorContentTime(ctx sdk.Context, creator string, contentHash string) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.DuplicateUserKeyPrefix))
+	bz := store.Get([]byte(creator + "/" + contentHash))
+	if bz == nil {
+		return 0, false
+	}
+	return btoi(bz), true
+}
+
+// SetCreatorContentTime records that creator posted the content hash at timestamp.
+func (k Keeper) SetCreatorContentTime(ctx sdk.Context, creator string, contentHash string, timestamp int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.DuplicateUserKeyPrefix))
+	store.Set([]byte(creator+"/"+contentHash), itob(timestamp))
+}
+
+// GetContentRepetition returns how often the content hash was posted in its current window.
+func (k Keeper) GetContentRepetition(ctx sdk.Context, contentHash string) (types.ContentRepetition, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.DuplicateGlobalKeyPrefix))
+	bz := store.Get([]byte(contentHash))
+	if bz == nil {
+		return types.ContentRepetition{}, false
+	}
+	var repetition types.ContentRepetition
+	if err := k.cdc.Unmarshal(bz, &repetition); err != nil {
+		types.LogError(k.logger, "unmarshal_content_repetition", err, "content_hash", contentHash)
+		return types.ContentRepetition{}, false
+	}
+	return repetition, true
+}
+
+func (k Keeper) SetContentRepetition(ctx sdk.Context, contentHash string, repetition types.ContentRepetition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.DuplicateGlobalKeyPrefix))
+	store.Set([]byte(contentHash), k.cdc.MustMarshal(&repetition))
+}
+
+// AddToDuplicateQueue queues a duplicate index key to be checked once expireAt has passed.
+func (k Keeper) AddToDuplicateQueue(ctx sdk.Context, expireAt int64, indexKey string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.DuplicateQueueKeyPrefix))
+	store.Set(append(itob(expireAt), []byte(indexKey)...), []byte(indexKey))
+}
+
+// PruneDuplicateIndex drops at most limit duplicate index entries that left the
+// window before now. An entry refreshed since it was queued is kept.
+func (k Keeper) PruneDuplicateIndex(ctx sdk.Context, now int64, window int64, limit int) {
+	store := ctx.KVStore(k.storeKey)
+	queueStore := prefix.NewStore(store, []byte(types.DuplicateQueueKeyPrefix))
+	iterator := queueStore.Iterator(nil, itob(now+1))
+	var queueKeys, indexKeys [][]byte
+	for ; iterator.Valid() && len(queueKeys) < limit; iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+		indexKeys = append(indexKeys, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, indexKey := range indexKeys {
+		queueStore.Delete(queueKeys[i])
+		var since int64
+		if contentHash, ok := strings.CutPrefix(string(indexKey), types.DuplicateGlobalKeyPrefix); ok {
+			repetition, _ := k.GetContentRepetition(ctx, contentHash)
+			since = repetition.WindowStart
+		} else {
+			since = btoi(store.Get(indexKey))
+		}
+		if since+window <= now {
+			store.Delete(indexKey)
+		}
+	}
+}
+
 func (k Keeper) GetUserCreatedPosts(ctx sdk.Context, creator string, page uint64) ([]string, *query.PageResponse, uint64, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserCreatedPostsKeyPrefix+creator))
 
@@ -654,6 +1084,30 @@ func (k Keeper) GetCommentsByParentId(ctx sdk.Context, parentId string, page uin
 	return ids, pageResponse, page, nil
 }
 
+// GetCommentRepliesPage returns up to limit comments of parentId, highest score
+// first, continuing after key. The returned key continues the listing and is nil
+// once every comment has been returned.
+func (k Keeper) GetCommentRepliesPage(ctx sdk.Context, parentId string, key []byte, limit uint64) ([]string, []byte, error) {
+	pageRequest := &query.PageRequest{
+		Key:     key,
+		Limit:   limit,
+		Reverse: true,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentListKeyPrefix))
+	commentStore := prefix.NewStore(store, []byte(parentId))
+
+	var ids []string
+	pageResponse, err := query.Paginate(commentStore, pageRequest, func(key []byte, value []byte) error {
+		ids = append(ids, string(value))
+		return nil
+	})
+	if err != nil {
+		types.LogError(k.logger, "get_comment_replies_page", err, "parent_id", parentId, "limit", limit)
+		return nil, nil, types.WrapError(types.ErrDatabaseOperation, "failed to paginate comment replies")
+	}
+	return ids, pageResponse.NextKey, nil
+}
+
 func (k Keeper) DeleteFromCommentList(ctx sdk.Context, postId string, commentId string, score uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentListKeyPrefix))
 	bzScore := k.EncodeScore(score)
@@ -665,6 +1119,89 @@ func (k Keeper) DeleteFromCommentList(ctx sdk.Context, postId string, commentId
 	store.Delete(key)
 }
 
+// GetCommentIdsByParentId returns the id of every comment stored under the given parent post.
+func (k Keeper) GetCommentIdsByParentId(ctx sdk.Context, parentId string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentListKeyPrefix+parentId))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var ids []string
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, string(iterator.Value()))
+	}
+	return ids
+}
+
+// HasComments reports whether any comment is listed under the given parent post.
+func (k Keeper) HasComments(ctx sdk.Context, parentId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentListKeyPrefix+parentId))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// PopCommentIds takes up to limit comments off the list of the given parent
+// post and returns their ids.
+func (k Keeper) PopCommentIds(ctx sdk.Context, parentId string, limit int) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentListKeyPrefix+parentId))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	var ids []string
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+		ids = append(ids, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return ids
+}
+
+// AddToCommentDeleteQueue queues the comments of a removed post or comment,
+// parentCreator is the creator of the removed parent.
+func (k Keeper) AddToCommentDeleteQueue(ctx sdk.Context, parentId string, parentCreator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentDeleteQueueKeyPrefix))
+	store.Set([]byte(parentId), []byte(parentCreator))
+}
+
+// DeleteFromCommentDeleteQueue removes a parent whose comments are all removed.
+func (k Keeper) DeleteFromCommentDeleteQueue(ctx sdk.Context, parentId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentDeleteQueueKeyPrefix))
+	store.Delete([]byte(parentId))
+}
+
+// GetCommentDeleteQueue returns up to limit queued parents along with their creators.
+func (k Keeper) GetCommentDeleteQueue(ctx sdk.Context, limit int) ([]string, []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentDeleteQueueKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var parentIds []string
+	var parentCreators []string
+	for ; iterator.Valid() && len(parentIds) < limit; iterator.Next() {
+		parentIds = append(parentIds, string(iterator.Key()))
+		parentCreators = append(parentCreators, string(iterator.Value()))
+	}
+	return parentIds, parentCreators
+}
+
+// DeleteCommentList removes every comment list entry stored under the given parent post.
+func (k Keeper) DeleteCommentList(ctx sdk.Context, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentListKeyPrefix+postId))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 func (k Keeper) SetCommentsReceived(ctx sdk.Context, creator string, commentId string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentsReceivedPrefix+creator+"/"))
 	blockTime := k.EncodeBlockTime(ctx)
@@ -672,6 +1209,12 @@ func (k Keeper) SetCommentsReceived(ctx sdk.Context, creator string, commentId s
 	store.Set(key, []byte(commentId))
 }
 
+func (k Keeper) DeleteFromCommentsReceived(ctx sdk.Context, creator string, commentId string, timestamp int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CommentsReceivedPrefix+creator+"/"))
+	key := append(itob(timestamp), []byte(commentId)...)
+	store.Delete(key)
+}
+
 func (k Keeper) GetCommentsReceived(ctx sdk.Context, creator string, page uint64) ([]string, *query.PageResponse, uint64, error) {
 	if page < 1 {
 		page = 1
@@ -825,6 +1368,13 @@ func (k Keeper) MarkUserSavedPost(ctx sdk.Context, sender, postId string) {
 	key := []byte(postId)
 	store.Set(key, blockTime)
 }
+
+// UnmarkUserSavedPost removes the user's save record for a specific post
+func (k Keeper) UnmarkUserSavedPost(ctx sdk.Context, sender, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserSavesPrefix+sender+"/"))
+	store.Delete([]byte(postId))
+}
+
 func (k Keeper) HasUserSavedPost(ctx sdk.Context, sender, postId string) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserSavesPrefix+sender+"/"))
 	key := []byte(postId)
@@ -955,6 +1505,33 @@ func (k Keeper) RemoveFromLikesReceived(ctx sdk.Context, creator string, sender
 	return types.NewPostNotFoundError(postId)
 }
 
+// RemoveLikesReceivedByPostId removes all likes and saves the creator received for postId
+// and returns the removed records.
+func (k Keeper) RemoveLikesReceivedByPostId(ctx sdk.Context, creator string, postId string) ([]types.LikesReceived, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LikesReceivedPrefix+creator+"/"))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	var removed []types.LikesReceived
+	for ; iterator.Valid(); iterator.Next() {
+		var received types.LikesReceived
+		if err := k.cdc.Unmarshal(iterator.Value(), &received); err != nil {
+			iterator.Close()
+			types.LogError(k.logger, "unmarshal_likes_received", err, "creator", creator, "post_id", postId)
+			return nil, types.WrapError(types.ErrDatabaseOperation, "failed to unmarshal LikesReceived")
+		}
+		if received.PostId == postId {
+			keys = append(keys, iterator.Key())
+			removed = append(removed, received)
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return removed, nil
+}
+
 func (k Keeper) PostReward(ctx sdk.Context, creator string) {
 	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, types.DenomBase)
 	if !found {
@@ -1017,6 +1594,12 @@ func (k Keeper) GetPost(ctx sdk.Context, id string) (types.Post, bool) {
 	return post, true
 }
 
+// DeletePost removes a post by ID from the state.
+func (k Keeper) DeletePost(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostKeyPrefix))
+	store.Delete([]byte(id))
+}
+
 // generatePostID generates a unique post ID.
 // This is a simple implementation using block time and some randomness.
 // Consider using a more robust method in production.
@@ -1112,6 +1695,11 @@ func (k Keeper) DeleteFromCategoryPostsByCategoryAndPostId(ctx sdk.Context, cate
 	key := append(bzBlockTime, []byte(postId)...)
 	store.Delete(key)
 }
+func (k Keeper) IsPostInCategoryPosts(ctx sdk.Context, category string, postId string, homePostsUpdate int64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CategoryPostsKeyPrefix+category))
+	key := append(itob(homePostsUpdate), []byte(postId)...)
+	return store.Has(key)
+}
 func (k Keeper) DeleteLastPostFromCategoryPosts(ctx sdk.Context, categoryHash string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CategoryPostsKeyPrefix+categoryHash))
 	iterator := store.Iterator(nil, nil)
@@ -1217,6 +1805,10 @@ func (k Keeper) GetCategoryByPostId(ctx sdk.Context, postId string) string {
 	}
 	return string(bz)
 }
+func (k Keeper) DeletePostCategoryMapping(ctx sdk.Context, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostCategoryMappingKeyPrefix))
+	store.Delete([]byte(postId))
+}
 
 func (k Keeper) AddCategory(ctx sdk.Context, category types.Category) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CategoryKeyPrefix))
@@ -1767,10 +2359,13 @@ func (k Keeper) GetUncategorizedTopics(ctx sdk.Context, page, limit int64) ([]st
 	return topics, pageRes, nil
 }
 
-func (k Keeper) SetPoll(ctx sdk.Context, id string, sender string, optionId int64) {
+// SetPoll stores the options a user selected in a poll
+func (k Keeper) SetPoll(ctx sdk.Context, id string, sender string, optionIds []int64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollUserPrefix+id+"/"))
-	optionIdBytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(optionIdBytes, uint64(optionId))
+	optionIdBytes := make([]byte, 0, 8*len(optionIds))
+	for _, optionId := range optionIds {
+		optionIdBytes = append(optionIdBytes, itob(optionId)...)
+	}
 	store.Set([]byte(sender), optionIdBytes)
 }
 
@@ -1780,13 +2375,127 @@ func (k Keeper) RemovePoll(ctx sdk.Context, id string, sender string) {
 	store.Delete([]byte(sender))
 }
 
-func (k Keeper) GetPoll(ctx sdk.Context, id string, sender string) (string, bool) {
+// GetPoll returns the options a user selected in a poll
+func (k Keeper) GetPoll(ctx sdk.Context, id string, sender string) ([]int64, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollUserPrefix+id+"/"))
 	b := store.Get([]byte(sender))
 	if b == nil {
-		return "", false
+		return nil, false
+	}
+	return decodePollOptions(b), true
+}
+
+// GetPollVotes returns every voter of a poll with the options they selected
+func (k Keeper) GetPollVotes(ctx sdk.Context, id string) ([]string, [][]int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollUserPrefix+id+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var voters []string
+	var optionIds [][]int64
+	for ; iterator.Valid(); iterator.Next() {
+		voters = append(voters, string(iterator.Key()))
+		optionIds = append(optionIds, decodePollOptions(iterator.Value()))
+	}
+	return voters, optionIds
+}
+
+func decodePollOptions(b []byte) []int64 {
+	optionIds := make([]int64, 0, len(b)/8)
+	for i := 0; i+8 <= len(b); i += 8 {
+		optionIds = append(optionIds, btoi(b[i:i+8]))
+	}
+	return optionIds
+}
+
+// SetPollCloseQueue queues a poll to be finalized once votingEnd has passed
+func (k Keeper) SetPollCloseQueue(ctx sdk.Context, votingEnd int64, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollCloseQueueKeyPrefix))
+	store.Set(append(itob(votingEnd), []byte(id)...), []byte(id))
+}
+
+// DeletePollCloseQueue removes a poll from the close queue
+func (k Keeper) DeletePollCloseQueue(ctx sdk.Context, votingEnd int64, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollCloseQueueKeyPrefix))
+	store.Delete(append(itob(votingEnd), []byte(id)...))
+}
+
+// GetClosedPolls returns, oldest first, up to limit queued polls whose
+// votingEnd is before blockTime, along with their votingEnd.
+func (k Keeper) GetClosedPolls(ctx sdk.Context, blockTime int64, limit int) ([]string, []int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollCloseQueueKeyPrefix))
+	iterator := store.Iterator(nil, itob(blockTime))
+	defer iterator.Close()
+
+	var ids []string
+	var votingEnds []int64
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, string(iterator.Value()))
+		votingEnds = append(votingEnds, btoi(iterator.Key()[:8]))
+	}
+	return ids, votingEnds
+}
+
+// GetPollVoters returns, in address order, up to limit voters of a poll that
+// come after the given voter. An empty after starts from the first voter.
+func (k Keeper) GetPollVoters(ctx sdk.Context, id string, after string, limit int) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollUserPrefix+id+"/"))
+	var start []byte
+	if after != "" {
+		start = append([]byte(after), 0)
+	}
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	var voters []string
+	for ; iterator.Valid() && len(voters) < limit; iterator.Next() {
+		voters = append(voters, string(iterator.Key()))
+	}
+	return voters
+}
+
+// SetPollNotifyQueue queues a finalized poll whose voters after lastVoter are
+// still to be notified
+func (k Keeper) SetPollNotifyQueue(ctx sdk.Context, id string, lastVoter string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollNotifyQueueKeyPrefix))
+	store.Set([]byte(id), []byte(lastVoter))
+}
+
+// DeletePollNotifyQueue removes a poll from the notify queue
+func (k Keeper) DeletePollNotifyQueue(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollNotifyQueueKeyPrefix))
+	store.Delete([]byte(id))
+}
+
+// GetPollNotifyQueue returns up to limit queued polls along with the last
+// voter notified of each.
+func (k Keeper) GetPollNotifyQueue(ctx sdk.Context, limit int) ([]string, []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollNotifyQueueKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var ids []string
+	var lastVoters []string
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, string(iterator.Key()))
+		lastVoters = append(lastVoters, string(iterator.Value()))
+	}
+	return ids, lastVoters
+}
+
+// DeletePollVotes removes all vote records of a poll
+func (k Keeper) DeletePollVotes(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PollUserPrefix+id+"/"))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
 	}
-	return string(b), true
 }
 
 func itob(v int64) []byte {
@@ -1877,6 +2586,166 @@ func (k Keeper) UnfollowTopic(ctx sdk.Context, address string, time uint64, topi
 	keyFlowing := append(itob(int64(time)), []byte(topicHash)...)
 	storeFlowing.Delete(keyFlowing)
 }
+
+// CanViewPost reports whether viewer is in the audience of post, a comment
+// sharing the audience of the post its thread hangs from. The creator always
+// sees their own posts; a close friends post whose list is gone is left to the
+// creator alone.
+func (k Keeper) CanViewPost(ctx sdk.Context, viewer string, post types.Post) bool {
+	if post.PostType == types.PostType_COMMENT && post.RootId != "" {
+		root, found := k.GetPost(ctx, post.RootId)
+		if !found {
+			return false
+		}
+		post = root
+	}
+	if !post.IsRestricted() || viewer == post.Creator {
+		return true
+	}
+	if viewer == "" {
+		return false
+	}
+	switch post.Audience {
+	case types.PostAudience_POST_AUDIENCE_FOLLOWERS:
+		_, following := k.ProfileKeeper.GetFollowTime(ctx, viewer, post.Creator)
+		return following
+	case types.PostAudience_POST_AUDIENCE_CLOSE_FRIENDS:
+		list, found := k.ProfileKeeper.GetUserList(ctx, post.AudienceListId)
+		return found && list.Owner == post.Creator && k.ProfileKeeper.IsListMember(ctx, list.Id, viewer)
+	}
+	return false
+}
+
+// IsOutOfPublicFeeds reports whether post must be kept out of the home, topic
+// and category feeds: restricted posts and the posts of private accounts.
+func (k Keeper) IsOutOfPublicFeeds(ctx sdk.Context, post types.Post) bool {
+	return post.IsRestricted() || k.ProfileKeeper.IsPrivate(ctx, post.Creator)
+}
+
+// GetUserCreatedPostIds returns the ids in the created posts list of creator,
+// reposts included, oldest first.
+func (k Keeper) GetUserCreatedPostIds(ctx sdk.Context, creator string) []string {
+	var postIds []string
+	k.iterateStore(ctx, types.UserCreatedPostsKeyPrefix+creator, func(_, value []byte) {
+		postIds = append(postIds, string(value))
+	})
+	return postIds
+}
+
+// GetListPosts merges the latest posts of members, newest first, and returns
+// the ids of the requested page with the page number.
+func (k Keeper) GetListPosts(ctx sdk.Context, members []string, page uint64) ([]string, uint64) {
+	if page < 1 {
+		page = 1
+	}
+	if page > types.MaxListPostsPage {
+		return []string{}, page
+	}
+	need := int(page * types.ListPostsPageSize)
+
+	var posts []types.Post
+	seen := make(map[string]bool)
+	for _, member := range members {
+		postIDs, _, err := k.GetLastPostsByAddress(ctx, member, need)
+		if err != nil {
+			continue
+		}
+		for _, postID := range postIDs {
+			// two members can repost the same post
+			if seen[postID] {
+				continue
+			}
+			if post, found := k.GetPost(ctx, postID); found {
+				seen[postID] = true
+				posts = append(posts, post)
+			}
+		}
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		if posts[i].Timestamp != posts[j].Timestamp {
+			return posts[i].Timestamp > posts[j].Timestamp
+		}
+		return posts[i].Id < posts[j].Id
+	})
+
+	first := int((page - 1) * types.ListPostsPageSize)
+	postIDs := []string{}
+	for i := first; i < len(posts) && i < need; i++ {
+		postIDs = append(postIDs, posts[i].Id)
+	}
+	return postIDs, page
+}
+
+// GetFollowSuggestions ranks the accounts viewer may want to follow. The
+// candidates are followed by the accounts viewer follows or posted lately in
+// the topics viewer follows, ties are broken by address so every node returns
+// the same list.
+func (k Keeper) GetFollowSuggestions(ctx sdk.Context, viewer string, limit int) []types.FollowSuggestion {
+	mutual := make(map[string]uint64)
+	following, _, _ := k.ProfileKeeper.GetFollowingPagination(ctx, viewer, 0, types.SuggestionFollowingScan)
+	for _, followed := range following {
+		secondDegree, _, _ := k.ProfileKeeper.GetFollowingPagination(ctx, followed, 0, types.SuggestionFollowingScan)
+		for _, candidate := range secondDegree {
+			mutual[candidate]++
+		}
+	}
+	topics, _, _, _ := k.GetFollowingTopics(ctx, viewer, 1)
+	for _, topic := range topics {
+		postIDs, _, _, _ := k.GetTopicPosts(ctx, topic, 1)
+		for _, postID := range postIDs {
+			post, found := k.GetPost(ctx, postID)
+			if _, seen := mutual[post.Creator]; found && !seen {
+				mutual[post.Creator] = 0
+			}
+		}
+	}
+
+	suggestions := make([]types.FollowSuggestion, 0, len(mutual))
+	for candidate, mutualCount := range mutual {
+		if !k.isSuggestible(ctx, viewer, candidate) {
+			continue
+		}
+		var sharedTopics uint64
+		for _, topic := range topics {
+			if _, found := k.GetFollowTopicTime(ctx, candidate, topic); found {
+				sharedTopics++
+			}
+		}
+		profile, _ := k.ProfileKeeper.GetProfile(ctx, candidate)
+		profile.WalletAddress = candidate
+		suggestions = append(suggestions, types.FollowSuggestion{
+			Profile:      &profile,
+			MutualCount:  mutualCount,
+			SharedTopics: sharedTopics,
+			Rank:         mutualCount*types.SuggestionMutualWeight + sharedTopics*types.SuggestionTopicWeight + profile.Score,
+		})
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Rank != suggestions[j].Rank {
+			return suggestions[i].Rank > suggestions[j].Rank
+		}
+		return suggestions[i].Profile.WalletAddress < suggestions[j].Profile.WalletAddress
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// isSuggestible reports whether candidate can be suggested to viewer, that is
+// viewer neither follows, asked to follow nor has a block with candidate.
+func (k Keeper) isSuggestible(ctx sdk.Context, viewer string, candidate string) bool {
+	if candidate == viewer || candidate == "" {
+		return false
+	}
+	if _, following := k.ProfileKeeper.GetFollowTime(ctx, viewer, candidate); following {
+		return false
+	}
+	return !k.ProfileKeeper.HasFollowRequest(ctx, viewer, candidate) &&
+		!k.ProfileKeeper.IsBlocked(ctx, viewer, candidate) &&
+		!k.ProfileKeeper.IsBlocked(ctx, candidate, viewer)
+}
+
 func (k Keeper) SetCategoryOperator(ctx sdk.Context, address string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CategoryOperatorKeyPrefix))
 	store.Set([]byte("operator"), []byte(address))
@@ -1951,3 +2820,79 @@ func (k Keeper) GetTxHashByPostId(ctx sdk.Context, postId string) (string, bool)
 	}
 	return string(bz), true
 }
+
+// DeletePostTxHashMapping removes the mapping from postId to txHash
+func (k Keeper) DeletePostTxHashMapping(ctx sdk.Context, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostTxHashMappingKeyPrefix))
+	store.Delete([]byte(postId))
+}
+
+// AddPostRevision stores an earlier version of a post, keyed by its revision number
+func (k Keeper) AddPostRevision(ctx sdk.Context, revision types.PostRevision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRevisionKeyPrefix+revision.PostId+"/"))
+	key := k.EncodeScore(revision.Revision)
+	bz := k.cdc.MustMarshal(&revision)
+	store.Set(key, bz)
+}
+
+func (k Keeper) SetPostRevisionCount(ctx sdk.Context, postId string, count int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRevisionCountKeyPrefix))
+	store.Set([]byte(postId), itob(count))
+}
+
+func (k Keeper) GetPostRevisionCount(ctx sdk.Context, postId string) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRevisionCountKeyPrefix))
+	bz := store.Get([]byte(postId))
+	if bz == nil {
+		return 0, true
+	}
+	return btoi(bz), true
+}
+
+// GetPostRevisions returns the revisions of a post, newest first
+func (k Keeper) GetPostRevisions(ctx sdk.Context, postId string, page uint64) ([]*types.PostRevision, *query.PageResponse, uint64, error) {
+	if page < 1 {
+		page = 1
+	}
+	offset := (page - 1) * types.PageSize
+	pageRequest := &query.PageRequest{
+		Offset:     offset,
+		Limit:      types.PageSize,
+		CountTotal: true,
+		Reverse:    true,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRevisionKeyPrefix+postId+"/"))
+
+	var revisions []*types.PostRevision
+	pageResponse, err := query.Paginate(store, pageRequest, func(key []byte, value []byte) error {
+		var revision types.PostRevision
+		if err := k.cdc.Unmarshal(value, &revision); err != nil {
+			return err
+		}
+		revisions = append(revisions, &revision)
+		return nil
+	})
+
+	if err != nil {
+		types.LogError(k.logger, "get_post_revisions", err, "post_id", postId, "page", page, "page_size", types.PageSize)
+		return nil, nil, uint64(0), types.WrapError(types.ErrDatabaseOperation, "failed to paginate post revisions")
+	}
+	return revisions, pageResponse, page, nil
+}
+
+// DeletePostRevisions removes the edit history of a post
+func (k Keeper) DeletePostRevisions(ctx sdk.Context, postId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRevisionKeyPrefix+postId+"/"))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	countStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PostRevisionCountKeyPrefix))
+	countStore.Delete([]byte(postId))
+}
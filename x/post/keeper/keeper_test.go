@@ -7,6 +7,8 @@ import (
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -28,6 +30,8 @@ import (
 	module "github.com/rollchains/tlock/x/post"
 	"github.com/rollchains/tlock/x/post/keeper"
 	"github.com/rollchains/tlock/x/post/types"
+	profilekeeper "github.com/rollchains/tlock/x/profile/keeper"
+	profiletypes "github.com/rollchains/tlock/x/profile/types"
 )
 
 var maccPerms = map[string][]string{
@@ -65,24 +69,30 @@ func SetupTest(t *testing.T) *testFixture {
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
 	f.govModAddr = authtypes.NewModuleAddress(govtypes.ModuleName).String()
-	f.addrs = simtestutil.CreateIncrementalAccounts(3)
+	f.addrs = simtestutil.CreateIncrementalAccounts(4)
 
-	keys := storetypes.NewKVStoreKeys(authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName, types.ModuleName)
+	keys := storetypes.NewKVStoreKeys(authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName, feegrant.StoreKey, profiletypes.StoreKey, types.ModuleName)
 	f.ctx = sdk.NewContext(integration.CreateMultiStore(keys, logger), cmtproto.Header{}, false, logger)
 
 	// Register SDK modules.
 	registerBaseSDKModules(logger, f, encCfg, keys)
 
 	// Setup Keeper.
-	//f.k = keeper.NewKeeper(
-	//	encCfg.Codec,
-	//	keys[types.ModuleName],
-	//	runtime.NewKVStoreService(keys[types.ModuleName]),
-	//	logger,
-	//	f.govModAddr,
-	//
+	feegrantKeeper := feegrantkeeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), f.accountkeeper)
+	profileKeeper := profilekeeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[profiletypes.StoreKey]), logger, f.govModAddr)
+	f.k = keeper.NewKeeper(
+		encCfg.Codec,
+		keys[types.ModuleName],
+		runtime.NewKVStoreService(keys[types.ModuleName]),
+		logger,
+		f.govModAddr,
+		f.accountkeeper,
+		f.bankkeeper,
+		feegrantKeeper,
+		profileKeeper,
+	)
 	f.msgServer = keeper.NewMsgServerImpl(f.k)
-	//f.queryServer = keeper.NewQuerier(f.k)
+	f.queryServer = keeper.NewQuerier(f.k, profileKeeper)
 	f.appModule = module.NewAppModule(encCfg.Codec, f.k)
 
 	return f
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/tlock/x/post/types"
+)
+
+// Migrator migrates the post store between consensus versions.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the given keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params added in version 2 to their defaults and fills
+// the state version 1 did not keep: the root and depth of every comment, the
+// comment count of a post over its whole thread rather than its direct
+// comments, the close queue of the open polls and the delete queue of the
+// comments whose parent is gone.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	params := k.GetParams(ctx)
+	params.MaxPinnedPosts = types.DefaultMaxPinnedPosts
+	params.DuplicateWindow = types.DefaultDuplicateWindow
+	params.DuplicateFlagThreshold = types.DefaultDuplicateFlags
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	posts := make(map[string]types.Post)
+	var ids []string
+	k.iterateStore(ctx, types.PostKeyPrefix, func(key, value []byte) {
+		var post types.Post
+		if err := k.cdc.Unmarshal(value, &post); err != nil {
+			types.LogError(k.logger, "migrate_post", err, "post_id", string(key))
+			return
+		}
+		posts[post.Id] = post
+		ids = append(ids, post.Id)
+	})
+
+	counts := make(map[string]uint64, len(posts))
+	var orphans []string
+	queued := make(map[string]bool)
+	for _, id := range ids {
+		comment := posts[id]
+		if comment.PostType != types.PostType_COMMENT {
+			continue
+		}
+		if _, ok := posts[comment.ParentId]; !ok {
+			if !queued[comment.ParentId] {
+				queued[comment.ParentId] = true
+				orphans = append(orphans, comment.ParentId)
+			}
+			continue
+		}
+		// the depth bound stops a cycle of parents from looping forever
+		rootId, depth := comment.ParentId, uint64(0)
+		for parentId := comment.ParentId; depth < uint64(len(posts)); {
+			parent, ok := posts[parentId]
+			if !ok {
+				break
+			}
+			counts[parent.Id] += 1
+			rootId = parent.Id
+			depth += 1
+			if parent.PostType != types.PostType_COMMENT {
+				break
+			}
+			parentId = parent.ParentId
+		}
+		comment.RootId, comment.Depth = rootId, depth
+		posts[id] = comment
+	}
+
+	for _, id := range ids {
+		post := posts[id]
+		post.CommentCount = counts[id]
+		k.SetPost(ctx, post)
+		if post.Poll != nil && !post.Poll.Finalized {
+			k.SetPollCloseQueue(ctx, post.Poll.VotingEnd, post.Id)
+		}
+	}
+	for _, parentId := range orphans {
+		k.AddToCommentDeleteQueue(ctx, parentId, "")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollchains/tlock/x/post/keeper"
+	"github.com/rollchains/tlock/x/post/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+
+	// version 1 counted the direct comments only and kept no comment roots
+	f.k.SetPost(f.ctx, types.Post{Id: "post1", Creator: alice, CommentCount: 1, Poll: &types.Poll{VotingEnd: 2000}})
+	f.k.SetPost(f.ctx, types.Post{Id: "comment1", PostType: types.PostType_COMMENT, ParentId: "post1", Creator: bob, CommentCount: 1})
+	f.k.SetPost(f.ctx, types.Post{Id: "comment2", PostType: types.PostType_COMMENT, ParentId: "comment1", Creator: alice})
+	f.k.SetPost(f.ctx, types.Post{Id: "orphan", PostType: types.PostType_COMMENT, ParentId: "deleted", Creator: bob})
+	f.k.AddToCommentList(f.ctx, "deleted", "orphan", 0)
+
+	require.NoError(keeper.NewMigrator(f.k).Migrate1to2(f.ctx))
+
+	post, _ := f.k.GetPost(f.ctx, "post1")
+	require.Equal(uint64(2), post.CommentCount)
+	comment, _ := f.k.GetPost(f.ctx, "comment2")
+	require.Equal("post1", comment.RootId)
+	require.Equal(uint64(2), comment.Depth)
+	comment, _ = f.k.GetPost(f.ctx, "comment1")
+	require.Equal("post1", comment.RootId)
+	require.Equal(uint64(1), comment.Depth)
+	require.Equal(uint64(1), comment.CommentCount)
+
+	closed, _ := f.k.GetClosedPolls(f.ctx, 2001, 10)
+	require.Equal([]string{"post1"}, closed)
+	queued, _ := f.k.GetCommentDeleteQueue(f.ctx, 10)
+	require.Equal([]string{"deleted"}, queued)
+	require.Equal(int64(types.DefaultDuplicateWindow), f.k.GetParams(f.ctx).DuplicateWindow)
+}
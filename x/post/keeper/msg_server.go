@@ -5,12 +5,14 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	"cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/rollchains/tlock/x/post/types"
 	profiletypes "github.com/rollchains/tlock/x/profile/types"
 )
@@ -55,6 +57,58 @@ func (ms msgServer) getPostWithValidation(ctx sdk.Context, postID string) (types
 	return post, nil
 }
 
+// Helper function to get a post that has not been taken down
+func (ms msgServer) getActivePost(ctx sdk.Context, postID string) (types.Post, error) {
+	post, err := ms.getPostWithValidation(ctx, postID)
+	if err != nil {
+		return types.Post{}, err
+	}
+	if post.TakedownStatus != types.TakedownStatus_TAKEDOWN_STATUS_NONE {
+		return types.Post{}, types.WrapErrorf(types.ErrRequestDenied, "post %s is taken down", postID)
+	}
+
+	return post, nil
+}
+
+// Helper function to reject a sender without the permission
+func (ms msgServer) requirePermission(ctx sdk.Context, address string, permission profiletypes.Permission) error {
+	if !ms.k.ProfileKeeper.HasPermission(ctx, address, permission) {
+		return types.WrapErrorf(types.ErrRequestDenied, "%s does not hold %s", address, permission)
+	}
+	return nil
+}
+
+// Helper function to reject a sender the owner has blocked
+func (ms msgServer) checkNotBlocked(ctx sdk.Context, owner string, sender string) error {
+	if ms.k.ProfileKeeper.IsBlocked(ctx, owner, sender) {
+		return types.WrapErrorf(types.ErrRequestDenied, "%s has blocked %s", owner, sender)
+	}
+	return nil
+}
+
+// Helper function to reject a sender outside the audience of the post
+func (ms msgServer) checkCanViewPost(ctx sdk.Context, post types.Post, sender string) error {
+	if !ms.k.CanViewPost(ctx, sender, post) {
+		return types.WrapErrorf(types.ErrRequestDenied, "post %s is not visible to %s", post.Id, sender)
+	}
+	return nil
+}
+
+// Helper function to reject a close friends list the creator does not own
+func (ms msgServer) checkAudienceList(ctx sdk.Context, creator string, postDetail *types.PostDetail) error {
+	if postDetail.Audience != types.PostAudience_POST_AUDIENCE_CLOSE_FRIENDS {
+		return nil
+	}
+	list, found := ms.k.ProfileKeeper.GetUserList(ctx, postDetail.AudienceListId)
+	if !found {
+		return types.WrapErrorf(types.ErrResourceNotFound, "list %d not found", postDetail.AudienceListId)
+	}
+	if list.Owner != creator {
+		return types.WrapErrorf(types.ErrRequestDenied, "list %d is not owned by %s", list.Id, creator)
+	}
+	return nil
+}
+
 // Helper function to log and return error
 func (ms msgServer) logAndReturnError(operation string, err error, context ...interface{}) error {
 	types.LogError(ms.k.logger, operation, err, context...)
@@ -65,6 +119,9 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 	if ms.k.authority != msg.Authority {
 		return nil, types.NewInvalidAddressErrorf("invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
 	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
 
 	return nil, ms.k.Params.Set(ctx, msg.Params)
 }
@@ -127,6 +184,23 @@ func (ms msgServer) validateCreatePostRequest(msg *types.MsgCreatePost) error {
 		return err
 	}
 
+	// Validate labels
+	if err := types.ValidateLabels(postDetail.Labels); err != nil {
+		return err
+	}
+
+	// Validate audience
+	if err := types.ValidateAudience(postDetail.Audience, postDetail.AudienceListId); err != nil {
+		return err
+	}
+
+	// Validate poll if present
+	if postDetail.Poll != nil {
+		if err := types.ValidatePoll(postDetail.Poll); err != nil {
+			return err
+		}
+	}
+
 	// Validate category if present
 	if postDetail.Category != "" {
 		if err := types.ValidateCategory(postDetail.Category); err != nil {
@@ -167,30 +241,55 @@ func (ms msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost)
 	if err := ms.validateAddress(msg.Creator); err != nil {
 		return nil, err
 	}
+	if err := ms.checkAudienceList(ctx, msg.Creator, postDetail); err != nil {
+		return nil, err
+	}
 
 	//if len(msg.Image) > MaxImageSize {
 	//	return nil, errors.Wrap(types.ErrInvalidRequest, "Image size exceeds the maximum allowed limit")
 	//}
 
+	if msg.PublishAt != 0 {
+		return ms.schedulePost(ctx, msg, txHash)
+	}
+
+	postId, err := ms.createPost(ctx, msg.Creator, postDetail, txHash)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreatePostResponse{
+		PostId: postId,
+	}, nil
+}
+
+// createPost stores a validated post and adds it to the home, user, topic and
+// category indexes, restricted posts only to the user index. It is shared by
+// CreatePost and the scheduled post EndBlocker.
+func (ms msgServer) createPost(ctx sdk.Context, creator string, postDetail *types.PostDetail, txHash string) (string, error) {
 	blockTime := ctx.BlockTime().Unix()
 	// Generate a unique post ID
 	var data string
 	var postType types.PostType
 	if postDetail.Title != "" {
 		if err := types.ValidatePostWithTitleContent(postDetail.Content); err != nil {
-			return nil, err
+			return "", err
 		}
 		postType = types.PostType_ARTICLE
-		data = fmt.Sprintf("%s|%s|%s|%d", msg.Creator, postDetail.Title, postDetail.Content, blockTime)
+		data = fmt.Sprintf("%s|%s|%s|%d", creator, postDetail.Title, postDetail.Content, blockTime)
 	} else {
 		if err := types.ValidatePostContent(postDetail.Content); err != nil {
-			return nil, err
+			return "", err
 		}
 		postType = types.PostType_ORIGINAL
-		data = fmt.Sprintf("%s|%s|%d", msg.Creator, postDetail.Content, blockTime)
+		data = fmt.Sprintf("%s|%s|%d", creator, postDetail.Content, blockTime)
 	}
 	postId := ms.k.sha256Generate(data)
 
+	contentHash, err := ms.checkDuplicateContent(ctx, creator, postDetail.Title, postDetail.Content)
+	if err != nil {
+		return "", err
+	}
+
 	// Create the post
 	post := types.Post{
 		Id: postId,
@@ -198,12 +297,15 @@ func (ms msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost)
 		PostType: postType,
 		//Title:           postDetail.Title,
 		Content:         postDetail.Content,
-		Creator:         msg.Creator,
+		Creator:         creator,
 		Timestamp:       blockTime,
 		ImagesUrl:       postDetail.ImagesUrl,
 		VideosUrl:       postDetail.VideosUrl,
 		HomePostsUpdate: blockTime,
 		Poll:            postDetail.Poll,
+		Labels:          postDetail.Labels,
+		Audience:        postDetail.Audience,
+		AudienceListId:  postDetail.AudienceListId,
 	}
 	if postDetail.Poll != nil {
 		post.PostType = types.PostType_POLL
@@ -219,57 +321,194 @@ func (ms msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost)
 		imageHash := ms.k.sha256Generate(paidImageBase64)
 		setPaidPostImageErr := ms.k.SetPaidPostImage(ctx, imageHash, postDetail.ImagesBase64[0])
 		if setPaidPostImageErr != nil {
-			return nil, setPaidPostImageErr
+			return "", setPaidPostImageErr
 		}
 		post.ImageIds = []string{imageHash}
 	}
 
+	// mentions add to activitiesReceived
+	post.Mentions = ms.notifyMentions(ctx, post, creator, postDetail.Mention, nil)
+
 	// post payment
 	//ms.k.postPayment(ctx, post)
 	// Store the post in the state
 	ms.k.SetPost(ctx, post)
+	if post.Poll != nil {
+		ms.k.SetPollCloseQueue(ctx, post.Poll.VotingEnd, postId)
+	}
 	// Store post to txHash mapping
 	ms.k.SetPostTxHashMapping(ctx, postId, txHash)
+	ms.recordContent(ctx, post, contentHash)
 	// add home posts
 	ms.addToHomePosts(ctx, post)
 	// add to user created posts
-	ms.addToUserCreatedPosts(ctx, msg.Creator, post)
-
-	ms.k.ProfileKeeper.CheckAndCreateUserHandle(ctx, msg.Creator)
+	ms.addToUserCreatedPosts(ctx, creator, post)
 
-	// mentions add to activitiesReceived
-	userHandleList := postDetail.Mention
-	if len(userHandleList) > 0 {
-		for _, userHandle := range userHandleList {
-			address := ms.k.ProfileKeeper.GetAddressByUserHandle(ctx, userHandle)
-			if address != "" {
-				ms.addActivitiesReceived(ctx, post, "", "", msg.Creator, address, profiletypes.ActivitiesType_ACTIVITIES_MENTION)
-			}
-		}
-	}
+	ms.k.ProfileKeeper.CheckAndCreateUserHandle(ctx, creator)
 
 	topicList := postDetail.Topic
 	category := postDetail.Category
-	err = ms.handleCategoryTopicPost(ctx, msg.Creator, topicList, category, blockTime, postId)
+	err = ms.handleCategoryTopicPost(ctx, creator, topicList, category, blockTime, postId)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	//Emit an event for the creation
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreatePaidPost,
-			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
 			sdk.NewAttribute(types.AttributeKeyPostID, postId),
 			sdk.NewAttribute(types.AttributeKeyTitle, postDetail.Title),
 			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", blockTime)),
 		),
 	})
+	return postId, nil
+}
+
+// checkDuplicateContent rejects content the creator already posted within the
+// duplicate window. It returns the hash of the normalized content.
+func (ms msgServer) checkDuplicateContent(ctx sdk.Context, creator string, title string, content string) (string, error) {
+	normalized := types.NormalizeContent(title, content)
+	window := ms.k.GetParams(ctx).DuplicateWindow
+	if normalized == "" || window <= 0 {
+		return "", nil
+	}
+	contentHash := ms.k.sha256Generate(normalized)
+	if last, found := ms.k.GetCreatorContentTime(ctx, creator, contentHash); found && ctx.BlockTime().Unix() < last+window {
+		return "", types.WrapErrorf(types.ErrDuplicateContent, "the same content was posted by %s at %d", creator, last)
+	}
+	return contentHash, nil
+}
+
+// recordContent adds a post to the duplicate index. Content repeated across the
+// chain more than the flag threshold within the window is flagged for moderation.
+func (ms msgServer) recordContent(ctx sdk.Context, post types.Post, contentHash string) {
+	if contentHash == "" {
+		return
+	}
+	params := ms.k.GetParams(ctx)
+	blockTime := ctx.BlockTime().Unix()
+	ms.k.SetCreatorContentTime(ctx, post.Creator, contentHash, blockTime)
+	ms.k.AddToDuplicateQueue(ctx, blockTime+params.DuplicateWindow, types.DuplicateUserKeyPrefix+post.Creator+"/"+contentHash)
+
+	repetition, found := ms.k.GetContentRepetition(ctx, contentHash)
+	if !found || blockTime >= repetition.WindowStart+params.DuplicateWindow {
+		repetition = types.ContentRepetition{WindowStart: blockTime}
+		ms.k.AddToDuplicateQueue(ctx, blockTime+params.DuplicateWindow, types.DuplicateGlobalKeyPrefix+contentHash)
+	}
+	repetition.Count++
+	threshold := params.DuplicateFlagThreshold
+	switch {
+	case threshold == 0:
+		// flagging is off, the post ids are not needed
+	case repetition.Count < threshold:
+		repetition.PostIds = append(repetition.PostIds, post.Id)
+	case repetition.Count == threshold:
+		for _, postId := range repetition.PostIds {
+			ms.flagDuplicate(ctx, postId, repetition.Count)
+		}
+		repetition.PostIds = nil
+		ms.flagDuplicate(ctx, post.Id, repetition.Count)
+	default:
+		ms.flagDuplicate(ctx, post.Id, repetition.Count)
+	}
+	ms.k.SetContentRepetition(ctx, contentHash, repetition)
+}
+
+// flagDuplicate reports a repeated post on behalf of the module.
+func (ms msgServer) flagDuplicate(ctx sdk.Context, postId string, count uint64) {
+	reporter := authtypes.NewModuleAddress(types.ModuleName).String()
+	post, found := ms.k.GetPost(ctx, postId)
+	if !found || post.TakedownStatus != types.TakedownStatus_TAKEDOWN_STATUS_NONE || ms.k.HasReport(ctx, postId, reporter) {
+		return
+	}
+	ms.addReport(ctx, types.Report{
+		PostId:    postId,
+		Reporter:  reporter,
+		Reason:    types.ReportReason_REPORT_REASON_SPAM,
+		Note:      fmt.Sprintf("the same content was posted %d times", count),
+		Timestamp: ctx.BlockTime().Unix(),
+	})
+}
+
+// schedulePost queues a validated post until msg.PublishAt.
+func (ms msgServer) schedulePost(ctx sdk.Context, msg *types.MsgCreatePost, txHash string) (*types.MsgCreatePostResponse, error) {
+	blockTime := ctx.BlockTime().Unix()
+	if msg.PublishAt <= blockTime {
+		return nil, types.NewInvalidRequestErrorf("publish_at %d must be after the block time %d", msg.PublishAt, blockTime)
+	}
+	if msg.PublishAt > blockTime+types.MaxScheduleAhead {
+		return nil, types.NewInvalidRequestErrorf("publish_at cannot be more than %d seconds ahead", types.MaxScheduleAhead)
+	}
+
+	postDetail := msg.GetPostDetail()
+	if postDetail.Title != "" {
+		if err := types.ValidatePostWithTitleContent(postDetail.Content); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := types.ValidatePostContent(postDetail.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	data := fmt.Sprintf("%s|%s|%s|%d|%d", msg.Creator, postDetail.Title, postDetail.Content, msg.PublishAt, blockTime)
+	scheduledId := ms.k.sha256Generate(data)
+	if _, found := ms.k.GetScheduledPost(ctx, scheduledId); found {
+		return nil, types.NewInvalidRequestError("post is already scheduled")
+	}
+	ms.k.SetScheduledPost(ctx, types.ScheduledPost{
+		Id:          scheduledId,
+		Creator:     msg.Creator,
+		PostDetail:  postDetail,
+		PublishAt:   msg.PublishAt,
+		ScheduledAt: blockTime,
+		TxHash:      txHash,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSchedulePost,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyScheduledID, scheduledId),
+			sdk.NewAttribute(types.AttributeKeyPublishAt, fmt.Sprintf("%d", msg.PublishAt)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", blockTime)),
+		),
+	})
 	return &types.MsgCreatePostResponse{
-		PostId: postId,
+		ScheduledId: scheduledId,
 	}, nil
 }
 
+// CancelScheduledPost implements types.MsgServer.
+func (ms msgServer) CancelScheduledPost(goCtx context.Context, msg *types.MsgCancelScheduledPostRequest) (*types.MsgCancelScheduledPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAddress(msg.Creator); err != nil {
+		return nil, err
+	}
+
+	scheduled, found := ms.k.GetScheduledPost(ctx, msg.ScheduledId)
+	if !found {
+		return nil, types.NewResourceNotFoundErrorf("scheduled post %s not found", msg.ScheduledId)
+	}
+	if scheduled.Creator != msg.Creator {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "only the creator can cancel scheduled post %s", scheduled.Id)
+	}
+	ms.k.DeleteScheduledPost(ctx, scheduled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelScheduledPost,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyScheduledID, scheduled.Id),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", ctx.BlockTime().Unix())),
+		),
+	})
+	return &types.MsgCancelScheduledPostResponse{Status: true}, nil
+}
+
 // CreateFreePostWithTitle implements types.MsgServer.
 //func (ms msgServer) CreateFreePostWithTitle(goCtx context.Context, msg *types.MsgCreateFreePostWithTitle) (*types.MsgCreateFreePostWithTitleResponse, error) {
 //	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -689,9 +928,18 @@ func (ms msgServer) QuotePost(goCtx context.Context, msg *types.MsgQuotePostRequ
 	ms.addToUserCreatedPosts(ctx, msg.Creator, post)
 	ms.k.ProfileKeeper.CheckAndCreateUserHandle(ctx, msg.Creator)
 
-	parentPost, found := ms.k.GetPost(ctx, msg.Quote)
-	if !found {
-		return nil, types.NewPostNotFoundError(msg.Quote)
+	parentPost, err := ms.getActivePost(ctx, msg.Quote)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.checkNotBlocked(ctx, parentPost.Creator, msg.Creator); err != nil {
+		return nil, err
+	}
+	if parentPost.IsRestricted() {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "post %s is not public", parentPost.Id)
+	}
+	if !ms.k.ProfileKeeper.CanViewPosts(ctx, msg.Creator, parentPost.Creator) {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "post %s is not visible to %s", parentPost.Id, msg.Creator)
 	}
 	parentPost.RepostCount += 1
 	ms.k.SetPost(ctx, parentPost)
@@ -709,7 +957,7 @@ func (ms msgServer) QuotePost(goCtx context.Context, msg *types.MsgQuotePostRequ
 		}
 		for _, userHandle := range userHandleList {
 			address := ms.k.ProfileKeeper.GetAddressByUserHandle(ctx, userHandle)
-			if address != "" {
+			if address != "" && !ms.k.ProfileKeeper.IsBlocked(ctx, address, msg.Creator) {
 				ms.addActivitiesReceived(ctx, post, "", "", msg.Creator, address, profiletypes.ActivitiesType_ACTIVITIES_MENTION)
 			}
 		}
@@ -750,20 +998,35 @@ func (ms msgServer) Repost(goCtx context.Context, msg *types.MsgRepostRequest) (
 		return nil, types.NewInvalidAddressErrorf("invalid sender address: %s", err)
 	}
 
-	parentPost, found := ms.k.GetPost(ctx, msg.Quote)
-	if !found {
-		return nil, types.NewPostNotFoundError(msg.Quote)
+	parentPost, err := ms.getActivePost(ctx, msg.Quote)
+	if err != nil {
+		return nil, err
+	}
+	if parentPost.IsRestricted() {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "post %s is not public", parentPost.Id)
 	}
+	if !ms.k.ProfileKeeper.CanViewPosts(ctx, msg.Creator, parentPost.Creator) {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "post %s is not visible to %s", parentPost.Id, msg.Creator)
+	}
+	if _, found := ms.k.GetRepost(ctx, msg.Creator, parentPost.Id); found {
+		return nil, types.ErrAlreadyReposted
+	}
+
+	blockTime := ctx.BlockTime().Unix()
 	// add home posts
 	//ms.addToHomePosts(ctx, parentPost)
 	// add to user created posts
 	ms.addToUserCreatedPosts(ctx, msg.Creator, parentPost)
+	ms.k.SetRepost(ctx, types.Repost{
+		Reposter:  msg.Creator,
+		PostId:    parentPost.Id,
+		Timestamp: blockTime,
+	})
 	ms.k.ProfileKeeper.CheckAndCreateUserHandle(ctx, msg.Creator)
 
 	parentPost.RepostCount += 1
 	ms.k.SetPost(ctx, parentPost)
 
-	blockTime := ctx.BlockTime().Unix()
 	//Emit an event for the repost
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -777,6 +1040,49 @@ func (ms msgServer) Repost(goCtx context.Context, msg *types.MsgRepostRequest) (
 	return &types.MsgRepostResponse{}, nil
 }
 
+// UndoRepost implements types.MsgServer.
+func (ms msgServer) UndoRepost(goCtx context.Context, msg *types.MsgUndoRepostRequest) (*types.MsgUndoRepostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAddress(msg.Creator); err != nil {
+		return nil, err
+	}
+
+	repost, found := ms.k.GetRepost(ctx, msg.Creator, msg.PostId)
+	if !found {
+		return nil, types.WrapErrorf(types.ErrRepostNotFound, "%s has not reposted post %s", msg.Creator, msg.PostId)
+	}
+	ms.removeRepost(ctx, repost)
+
+	if post, found := ms.k.GetPost(ctx, repost.PostId); found && post.RepostCount > 0 {
+		post.RepostCount -= 1
+		ms.k.SetPost(ctx, post)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndoRepost,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPostID, msg.PostId),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", blockTime)),
+		),
+	})
+
+	return &types.MsgUndoRepostResponse{Status: true}, nil
+}
+
+// removeRepost deletes a repost record and its entry in the reposter's created posts.
+func (ms msgServer) removeRepost(ctx sdk.Context, repost types.Repost) {
+	if ms.k.DeleteFromUserCreatedPosts(ctx, repost.Reposter, repost.PostId, repost.Timestamp) {
+		count, _ := ms.k.GetUserCreatedPostsCount(ctx, repost.Reposter)
+		if count > 0 {
+			ms.k.SetUserCreatedPostsCount(ctx, repost.Reposter, count-1)
+		}
+	}
+	ms.k.DeleteRepost(ctx, repost.Reposter, repost.PostId)
+}
+
 // LikePost implements types.MsgServer.
 func (ms msgServer) Like(goCtx context.Context, msg *types.MsgLikeRequest) (*types.MsgLikeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -789,11 +1095,15 @@ func (ms msgServer) Like(goCtx context.Context, msg *types.MsgLikeRequest) (*typ
 
 	ms.k.MarkUserLikedPost(ctx, msg.Sender, msg.Id)
 
-	post, err := ms.getPostWithValidation(ctx, msg.Id)
+	post, err := ms.getActivePost(ctx, msg.Id)
 	if err != nil {
 		ms.k.UnmarkUserLikedPost(ctx, msg.Sender, msg.Id)
 		return nil, err
 	}
+	if err := ms.checkCanViewPost(ctx, post, msg.Sender); err != nil {
+		ms.k.UnmarkUserLikedPost(ctx, msg.Sender, msg.Id)
+		return nil, err
+	}
 
 	oldScore := post.Score
 	// Score Accumulation
@@ -929,10 +1239,13 @@ func (ms msgServer) SavePost(goCtx context.Context, msg *types.MsgSaveRequest) (
 		return nil, types.ErrAlreadySaved
 	}
 	// Retrieve the post by ID
-	post, err := ms.getPostWithValidation(ctx, msg.Id)
+	post, err := ms.getActivePost(ctx, msg.Id)
 	if err != nil {
 		return nil, err
 	}
+	if err := ms.checkCanViewPost(ctx, post, msg.Sender); err != nil {
+		return nil, err
+	}
 
 	// Check if the post type is COMMENT
 	if post.PostType == types.PostType_COMMENT {
@@ -1038,13 +1351,29 @@ func (ms msgServer) Comment(goCtx context.Context, msg *types.MsgCommentRequest)
 		Timestamp: blockTime,
 	}
 
-	// Store the post in the state
-	ms.k.SetPost(ctx, comment)
-
-	post, err := ms.getPostWithValidation(ctx, msg.ParentId)
+	post, err := ms.getActivePost(ctx, msg.ParentId)
 	if err != nil {
 		return nil, err
 	}
+	if err := ms.checkCanViewPost(ctx, post, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := ms.checkNotBlocked(ctx, post.Creator, msg.Creator); err != nil {
+		return nil, err
+	}
+	if post.RootId != "" {
+		if root, found := ms.k.GetPost(ctx, post.RootId); found {
+			if err := ms.checkNotBlocked(ctx, root.Creator, msg.Creator); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// place the comment in its thread, every comment above it counts the reply
+	comment.RootId, comment.Depth = ms.bubbleCommentCount(ctx, post)
+
+	// Store the post in the state
+	ms.k.SetPost(ctx, comment)
 
 	oldScore := post.Score
 	// Score Accumulation
@@ -1103,7 +1432,8 @@ func (ms msgServer) Comment(goCtx context.Context, msg *types.MsgCommentRequest)
 		}
 		for _, userHandle := range userHandleList {
 			address := ms.k.ProfileKeeper.GetAddressByUserHandle(ctx, userHandle)
-			if address != "" {
+			// the mention carries the parent post, keep it within its audience
+			if address != "" && !ms.k.ProfileKeeper.IsBlocked(ctx, address, msg.Creator) && ms.k.CanViewPost(ctx, address, post) {
 				ms.addActivitiesReceived(ctx, post, "", "", msg.Creator, address, profiletypes.ActivitiesType_ACTIVITIES_MENTION)
 			}
 		}
@@ -1121,7 +1451,30 @@ func (ms msgServer) Comment(goCtx context.Context, msg *types.MsgCommentRequest)
 	return &types.MsgCommentResponse{}, nil
 }
 
+// bubbleCommentCount increments the comment count of every comment above parent
+// and of the post the thread hangs from. It returns that post's id and the depth
+// of a new reply to parent.
+func (ms msgServer) bubbleCommentCount(ctx sdk.Context, parent types.Post) (string, uint64) {
+	rootId, depth := parent.Id, uint64(1)
+	for current := parent; current.PostType == types.PostType_COMMENT; {
+		ancestor, found := ms.k.GetPost(ctx, current.ParentId)
+		if !found {
+			types.LogError(ms.k.logger, "bubble_comment_count", types.ErrPostNotFound, "post_id", current.ParentId)
+			break
+		}
+		ancestor.CommentCount += 1
+		ms.k.SetPost(ctx, ancestor)
+		rootId = ancestor.Id
+		depth += 1
+		current = ancestor
+	}
+	return rootId, depth
+}
+
 func (ms msgServer) updateHomePosts(ctx sdk.Context, post types.Post) {
+	if ms.k.IsOutOfPublicFeeds(ctx, post) {
+		return
+	}
 	ms.k.DeleteFromHomePostsByPostId(ctx, post.Id, post.HomePostsUpdate)
 	ms.k.SetHomePosts(ctx, post.Id)
 	count, b := ms.k.GetHomePostsCount(ctx)
@@ -1137,6 +1490,9 @@ func (ms msgServer) updateHomePosts(ctx sdk.Context, post types.Post) {
 }
 
 func (ms msgServer) addToHomePosts(ctx sdk.Context, post types.Post) {
+	if ms.k.IsOutOfPublicFeeds(ctx, post) {
+		return
+	}
 	ms.k.SetHomePosts(ctx, post.Id)
 	count, b := ms.k.GetHomePostsCount(ctx)
 	//ms.k.Logger().Warn("==========b:{}", b)
@@ -1168,6 +1524,9 @@ func (ms msgServer) addToUserCreatedPosts(ctx sdk.Context, creator string, post
 }
 
 func (ms msgServer) addToTopicPosts(ctx sdk.Context, topicHash string, postId string) {
+	if ms.isOutOfPublicFeeds(ctx, postId) {
+		return
+	}
 	ms.k.SetTopicPosts(ctx, topicHash, postId)
 	count, b := ms.k.GetTopicPostsCount(ctx, topicHash)
 	if !b {
@@ -1186,17 +1545,20 @@ func (ms msgServer) updateTopicPosts(ctx sdk.Context, post types.Post, uintExpon
 	topics := ms.k.GetTopicsByPostId(ctx, post.Id)
 	if len(topics) > 0 {
 		for _, topicHash := range topics {
-			ms.k.DeleteFromTopicPostsByTopicAndPostId(ctx, topicHash, post.Id, post.HomePostsUpdate)
-			ms.k.SetTopicPosts(ctx, topicHash, post.Id)
-			count, b := ms.k.GetTopicPostsCount(ctx, topicHash)
-			if !b {
-				types.LogError(ms.k.logger, "updateTopicPosts", types.ErrDatabaseOperation, "operation", "GetTopicPostsCount", "topicHash", topicHash)
-				return
-			}
-			if count > types.TopicPostsCount {
-				ms.k.DeleteLastPostFromTopicPosts(ctx, topicHash)
-				count -= 1
-				ms.k.SetTopicPostsCount(ctx, topicHash, count)
+			// a post kept out of the public feeds still scores its topics
+			if !ms.k.IsOutOfPublicFeeds(ctx, post) {
+				ms.k.DeleteFromTopicPostsByTopicAndPostId(ctx, topicHash, post.Id, post.HomePostsUpdate)
+				ms.k.SetTopicPosts(ctx, topicHash, post.Id)
+				count, b := ms.k.GetTopicPostsCount(ctx, topicHash)
+				if !b {
+					types.LogError(ms.k.logger, "updateTopicPosts", types.ErrDatabaseOperation, "operation", "GetTopicPostsCount", "topicHash", topicHash)
+					return
+				}
+				if count > types.TopicPostsCount {
+					ms.k.DeleteLastPostFromTopicPosts(ctx, topicHash)
+					count -= 1
+					ms.k.SetTopicPostsCount(ctx, topicHash, count)
+				}
 			}
 
 			//update topic
@@ -1421,6 +1783,28 @@ func (ms msgServer) ScoreAccumulation(ctx sdk.Context, operator string, post typ
 //	return &types.MsgMentionResponse{}, nil
 //}
 
+// notifyMentions sends a mention of post to the owner of every handle who has
+// not blocked the sender and is in the audience of the post, skipping the
+// addresses already notified. It returns the mentioned addresses.
+func (ms msgServer) notifyMentions(ctx sdk.Context, post types.Post, sender string, userHandles []string, notified []string) []string {
+	var mentioned []string
+	for _, userHandle := range userHandles {
+		address := ms.k.ProfileKeeper.GetAddressByUserHandle(ctx, userHandle)
+		if address == "" || slices.Contains(mentioned, address) {
+			continue
+		}
+		// a mention outside the audience would leak the post
+		if ms.k.ProfileKeeper.IsBlocked(ctx, address, sender) || !ms.k.CanViewPost(ctx, address, post) {
+			continue
+		}
+		mentioned = append(mentioned, address)
+		if !slices.Contains(notified, address) {
+			ms.addActivitiesReceived(ctx, post, "", "", sender, address, profiletypes.ActivitiesType_ACTIVITIES_MENTION)
+		}
+	}
+	return mentioned
+}
+
 func (ms msgServer) addActivitiesReceived(sdkCtx sdk.Context, parentPost types.Post, commentId string, content string,
 	operator string, target string, activitiesType profiletypes.ActivitiesType) {
 	blockTime := sdkCtx.BlockTime().Unix()
@@ -1441,7 +1825,12 @@ func (ms msgServer) addActivitiesReceived(sdkCtx sdk.Context, parentPost types.P
 		activitiesReceived.CommentId = commentId
 		activitiesReceived.Content = content
 		activitiesReceived.ParentId = parentPost.Id
-	} else if activitiesType == profiletypes.ActivitiesType_ACTIVITIES_MENTION {
+	} else if activitiesType == profiletypes.ActivitiesType_ACTIVITIES_MENTION || activitiesType == profiletypes.ActivitiesType_ACTIVITIES_POLL_CLOSED {
+		activitiesReceived.ParentId = parentPost.Id
+	} else if activitiesType == profiletypes.ActivitiesType_ACTIVITIES_TAKEDOWN ||
+		activitiesType == profiletypes.ActivitiesType_ACTIVITIES_TAKEDOWN_APPEAL ||
+		activitiesType == profiletypes.ActivitiesType_ACTIVITIES_APPEAL_DECISION {
+		activitiesReceived.Content = content
 		activitiesReceived.ParentId = parentPost.Id
 	}
 
@@ -1552,7 +1941,16 @@ func (ms msgServer) handleCategoryTopicPost(ctx sdk.Context, creator string, top
 	return nil
 }
 
+// isOutOfPublicFeeds reports whether postId must be kept out of the public feeds.
+func (ms msgServer) isOutOfPublicFeeds(ctx sdk.Context, postId string) bool {
+	post, found := ms.k.GetPost(ctx, postId)
+	return found && ms.k.IsOutOfPublicFeeds(ctx, post)
+}
+
 func (ms msgServer) addToCategoryPosts(ctx sdk.Context, categoryHash string, postId string) {
+	if ms.isOutOfPublicFeeds(ctx, postId) {
+		return
+	}
 	ms.k.SetCategoryPosts(ctx, categoryHash, postId)
 	count, b := ms.k.GetCategoryPostsCount(ctx, categoryHash)
 	if !b {
@@ -1567,6 +1965,9 @@ func (ms msgServer) addToCategoryPosts(ctx sdk.Context, categoryHash string, pos
 	}
 }
 func (ms msgServer) updateCategoryPosts(ctx sdk.Context, post types.Post) {
+	if ms.k.IsOutOfPublicFeeds(ctx, post) {
+		return
+	}
 	category := ms.k.GetCategoryByPostId(ctx, post.Id)
 	ms.k.DeleteFromCategoryPostsByCategoryAndPostId(ctx, category, post.Id, post.HomePostsUpdate)
 	ms.k.SetCategoryPosts(ctx, category, post.Id)
@@ -1596,58 +1997,163 @@ func (ms msgServer) addToCategoryTopics(ctx sdk.Context, categoryHash string, to
 	}
 }
 
-// CastVoteOnPoll implements types.MsgServer.
-func (ms msgServer) CastVoteOnPoll(goCtx context.Context, msg *types.CastVoteOnPollRequest) (*types.CastVoteOnPollResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-	blockTime := ctx.BlockTime().Unix()
-
-	// Validate and get parent post
-	parentPost, err := ms.getPostWithValidation(ctx, msg.Id)
-	if err != nil {
-		return nil, err
+func (ms msgServer) removeFromHomePosts(ctx sdk.Context, post types.Post) {
+	if !ms.k.IsPostInHomePosts(ctx, post.Id, post.HomePostsUpdate) {
+		return
 	}
-
-	// Validate poll exists
-	if parentPost.Poll == nil {
-		return nil, types.NewInvalidRequestError("post does not contain a poll")
+	ms.k.DeleteFromHomePostsByPostId(ctx, post.Id, post.HomePostsUpdate)
+	count, _ := ms.k.GetHomePostsCount(ctx)
+	if count > 0 {
+		ms.k.SetHomePostsCount(ctx, count-1)
 	}
+}
 
-	if blockTime < parentPost.Poll.VotingStart {
-		return nil, types.ErrVotingNotStarted
+func (ms msgServer) removeFromUserCreatedPosts(ctx sdk.Context, creator string, postId string) {
+	ms.k.DeleteUserPin(ctx, creator, postId)
+	removed := ms.k.DeleteFromUserCreatedPostsByPostId(ctx, creator, postId)
+	if removed == 0 {
+		return
 	}
-	if blockTime > parentPost.Poll.VotingEnd {
-		return nil, types.ErrVotingEnded
+	count, _ := ms.k.GetUserCreatedPostsCount(ctx, creator)
+	count -= removed
+	if count < 0 {
+		count = 0
 	}
+	ms.k.SetUserCreatedPostsCount(ctx, creator, count)
+}
 
-	_, alreadyVoted := ms.k.GetPoll(ctx, msg.Id, msg.Creator)
-	if alreadyVoted {
-		return nil, types.ErrAlreadyVoted
+func (ms msgServer) removeFromTopicPosts(ctx sdk.Context, topicHash string, post types.Post) {
+	ms.k.DeleteTopicPin(ctx, topicHash, post.Id)
+	if !ms.k.IsPostInTopicPosts(ctx, topicHash, post.Id, post.HomePostsUpdate) {
+		return
 	}
+	ms.k.DeleteFromTopicPostsByTopicAndPostId(ctx, topicHash, post.Id, post.HomePostsUpdate)
+	count, _ := ms.k.GetTopicPostsCount(ctx, topicHash)
+	if count > 0 {
+		ms.k.SetTopicPostsCount(ctx, topicHash, count-1)
+	}
+}
 
-	ms.k.SetPoll(ctx, msg.Id, msg.Creator, msg.OptionId)
-
-	validOptionId := false
-	for _, option := range parentPost.Poll.Vote {
-		if option.Id == msg.OptionId {
-			validOptionId = true
-			break
+func (ms msgServer) removeFromCategoryPosts(ctx sdk.Context, categoryHash string, post types.Post) {
+	if !ms.k.IsPostInCategoryPosts(ctx, categoryHash, post.Id, post.HomePostsUpdate) {
+		return
+	}
+	ms.k.DeleteFromCategoryPostsByCategoryAndPostId(ctx, categoryHash, post.Id, post.HomePostsUpdate)
+	count, _ := ms.k.GetCategoryPostsCount(ctx, categoryHash)
+	if count > 0 {
+		ms.k.SetCategoryPostsCount(ctx, categoryHash, count-1)
+	}
+}
+
+// removeFromFeeds drops the post from the home, user created, topic and category feeds
+// while keeping its topic and category mappings.
+func (ms msgServer) removeFromFeeds(ctx sdk.Context, post types.Post) {
+	ms.removeFromPublicFeeds(ctx, post)
+	ms.removeFromUserCreatedPosts(ctx, post.Creator, post.Id)
+}
+
+// removeFromPublicFeeds drops the post from the home, topic and category feeds.
+func (ms msgServer) removeFromPublicFeeds(ctx sdk.Context, post types.Post) {
+	ms.removeFromHomePosts(ctx, post)
+	for _, topicHash := range ms.k.GetTopicsByPostId(ctx, post.Id) {
+		ms.removeFromTopicPosts(ctx, topicHash, post)
+	}
+	if category := ms.k.GetCategoryByPostId(ctx, post.Id); category != "" {
+		ms.removeFromCategoryPosts(ctx, category, post)
+	}
+}
+
+// addToPublicFeeds puts the post back at its original position in the home,
+// topic and category feeds it is missing from.
+func (ms msgServer) addToPublicFeeds(ctx sdk.Context, post types.Post) {
+	feedCtx := atTime(ctx, post.HomePostsUpdate)
+	if !ms.k.IsPostInHomePosts(ctx, post.Id, post.HomePostsUpdate) {
+		ms.addToHomePosts(feedCtx, post)
+	}
+	for _, topicHash := range ms.k.GetTopicsByPostId(ctx, post.Id) {
+		if !ms.k.IsPostInTopicPosts(ctx, topicHash, post.Id, post.HomePostsUpdate) {
+			ms.addToTopicPosts(feedCtx, topicHash, post.Id)
 		}
 	}
-	if !validOptionId {
-		ms.k.RemovePoll(ctx, msg.Id, msg.Creator)
-		return nil, types.NewInvalidRequestError("invalid option ID")
+	category := ms.k.GetCategoryByPostId(ctx, post.Id)
+	if category != "" && !ms.k.IsPostInCategoryPosts(ctx, category, post.Id, post.HomePostsUpdate) {
+		ms.addToCategoryPosts(feedCtx, category, post.Id)
 	}
+}
 
-	parentPost.Poll.TotalVotes += 1
-	voteList := parentPost.Poll.Vote
-	for i := range voteList {
-		if parentPost.Poll.Vote[i].Id == msg.OptionId {
-			parentPost.Poll.Vote[i].Count += 1
-			break
+// CastVoteOnPoll implements types.MsgServer.
+func (ms msgServer) CastVoteOnPoll(goCtx context.Context, msg *types.CastVoteOnPollRequest) (*types.CastVoteOnPollResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	// Validate and get parent post
+	parentPost, err := ms.getActivePost(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.checkCanViewPost(ctx, parentPost, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// Validate poll exists
+	if parentPost.Poll == nil {
+		return nil, types.NewInvalidRequestError("post does not contain a poll")
+	}
+
+	if blockTime < parentPost.Poll.VotingStart {
+		return nil, types.ErrVotingNotStarted
+	}
+	if blockTime > parentPost.Poll.VotingEnd {
+		return nil, types.ErrVotingEnded
+	}
+
+	poll := parentPost.Poll
+	optionIds := msg.OptionIds
+	if len(optionIds) == 0 {
+		optionIds = []int64{msg.OptionId}
+	}
+	maxSelections := poll.MaxSelections
+	if maxSelections == 0 {
+		maxSelections = 1
+	}
+	if uint64(len(optionIds)) > maxSelections {
+		return nil, types.NewInvalidRequestErrorf("at most %d options can be selected", maxSelections)
+	}
+	selected := make(map[int64]bool, len(optionIds))
+	for _, optionId := range optionIds {
+		if selected[optionId] {
+			return nil, types.NewInvalidRequestErrorf("option ID %d selected twice", optionId)
+		}
+		selected[optionId] = true
+	}
+	for _, optionId := range optionIds {
+		if !slices.ContainsFunc(poll.Vote, func(option *types.Vote) bool { return option.Id == optionId }) {
+			return nil, types.NewInvalidRequestError("invalid option ID")
 		}
 	}
 
-	ms.k.SetPost(ctx, parentPost)
+	previous, alreadyVoted := ms.k.GetPoll(ctx, msg.Id, msg.Creator)
+	if alreadyVoted && !poll.AllowVoteChange {
+		return nil, types.ErrAlreadyVoted
+	}
+
+	ms.k.SetPoll(ctx, msg.Id, msg.Creator, optionIds)
+
+	// hidden results are only counted when the poll is finalized
+	if !poll.HideResults {
+		if !alreadyVoted {
+			poll.TotalVotes += 1
+		}
+		for i := range poll.Vote {
+			if slices.Contains(previous, poll.Vote[i].Id) {
+				poll.Vote[i].Count -= 1
+			}
+			if selected[poll.Vote[i].Id] {
+				poll.Vote[i].Count += 1
+			}
+		}
+		ms.k.SetPost(ctx, parentPost)
+	}
 
 	return &types.CastVoteOnPollResponse{Status: true}, nil
 }
@@ -1656,7 +2162,7 @@ func (ms msgServer) CastVoteOnPoll(goCtx context.Context, msg *types.CastVoteOnP
 func (ms msgServer) AddCategory(ctx context.Context, msg *types.AddCategoryRequest) (*types.AddCategoryResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	creator := msg.Creator
-	isAdmin := ms.k.ProfileKeeper.IsAdmin(sdkCtx, creator)
+	isAdmin := ms.k.ProfileKeeper.HasPermission(sdkCtx, creator, profiletypes.Permission_PERMISSION_MANAGE_CATEGORIES)
 	if isAdmin {
 		params := msg.Params
 		name := params.Name
@@ -1672,9 +2178,14 @@ func (ms msgServer) AddCategory(ctx context.Context, msg *types.AddCategoryReque
 			Avatar: params.Avatar,
 			Index:  index,
 		}
+		var oldValue string
+		if ms.k.CategoryExists(sdkCtx, id) {
+			oldValue = categoryAuditValue(ms.k.GetCategory(sdkCtx, id))
+		}
 		ms.k.AddCategory(sdkCtx, category)
 		ms.k.AddCategoryWithIndex(sdkCtx, category)
 		ms.k.SetCategoryIndex(sdkCtx, index)
+		ms.k.ProfileKeeper.AppendAuditLog(sdkCtx, creator, profiletypes.AuditAction_AUDIT_ACTION_ADD_CATEGORY, id, oldValue, categoryAuditValue(category))
 
 		sdkCtx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
@@ -1698,9 +2209,14 @@ func (ms msgServer) AddCategory(ctx context.Context, msg *types.AddCategoryReque
 func (ms msgServer) DeleteCategory(ctx context.Context, msg *types.DeleteCategoryRequest) (*types.DeleteCategoryResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	creator := msg.Creator
-	isAdmin := ms.k.ProfileKeeper.IsAdmin(sdkCtx, creator)
+	isAdmin := ms.k.ProfileKeeper.HasPermission(sdkCtx, creator, profiletypes.Permission_PERMISSION_MANAGE_CATEGORIES)
 	if isAdmin {
+		var oldValue string
+		if ms.k.CategoryExists(sdkCtx, msg.Id) {
+			oldValue = categoryAuditValue(ms.k.GetCategory(sdkCtx, msg.Id))
+		}
 		ms.k.DeleteCategory(sdkCtx, msg.Id)
+		ms.k.ProfileKeeper.AppendAuditLog(sdkCtx, creator, profiletypes.AuditAction_AUDIT_ACTION_DELETE_CATEGORY, msg.Id, oldValue, "")
 		sdkCtx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeDeleteCategory,
@@ -1722,24 +2238,19 @@ func (ms msgServer) DeleteCategory(ctx context.Context, msg *types.DeleteCategor
 // UpdateTopic implements types.MsgServer.
 func (ms msgServer) UpdateTopic(goCtx context.Context, msg *types.UpdateTopicRequest) (*types.UpdateTopicResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	//creator := msg.Creator
-	//isAdmin := ms.k.ProfileKeeper.IsAdmin(ctx, creator)
-	//if isAdmin {
-	//	json := msg.TopicJson
-	//	id := json.Id
-	//	topic, _ := ms.k.GetTopic(ctx, id)
-	//	//ms.k.deleteFromHotTopics72(ctx, id, topic.Score)
-	//	topic.Score = json.Score
-	//	if json.Avatar != "" {
-	//		//topic.Avatar = json.Avatar
-	//		ms.k.SetTopicAvatar(ctx, id, json.Avatar)
-	//	}
-	//	ms.k.AddTopic(ctx, topic)
-	//	//ms.k.addToHotTopics72(ctx, id, topic.Score)
-	//}
 	json := msg.TopicJson
 	id := json.Id
-	topic, _ := ms.k.GetTopic(ctx, id)
+	topic, found := ms.k.GetTopic(ctx, id)
+	oldValue := topicAuditValue(topic)
+	if !ms.k.ProfileKeeper.HasPermission(ctx, msg.Creator, profiletypes.Permission_PERMISSION_EDIT_TOPICS) {
+		// the admin of a topic may only edit how it is presented
+		if !found || topic.TopicAdmin != msg.Creator {
+			return nil, types.WrapErrorf(types.ErrRequestDenied, "%s can not edit topic %s", msg.Creator, id)
+		}
+		if json.Score > 0 || json.CategoryId != "" {
+			return nil, types.WrapError(types.ErrRequestDenied, "topic admins can only edit the title, summary and image")
+		}
+	}
 	if json.Title != "" {
 		topic.Title = json.Title
 	}
@@ -1762,10 +2273,25 @@ func (ms msgServer) UpdateTopic(goCtx context.Context, msg *types.UpdateTopicReq
 	if json.Image != "" {
 		ms.k.SetTopicImage(ctx, id, json.Image)
 	}
+	newValue := topicAuditValue(topic)
+	if json.Image != "" {
+		newValue += fmt.Sprintf(" image=%q", json.Image)
+	}
+	ms.k.ProfileKeeper.AppendAuditLog(ctx, msg.Creator, profiletypes.AuditAction_AUDIT_ACTION_UPDATE_TOPIC, id, oldValue, newValue)
 
 	return &types.UpdateTopicResponse{}, nil
 }
 
+// topicAuditValue describes the editable fields of a topic in the audit log
+func topicAuditValue(topic types.Topic) string {
+	return fmt.Sprintf("title=%q summary=%q score=%d category_id=%s", topic.Title, topic.Summary, topic.Score, topic.CategoryId)
+}
+
+// categoryAuditValue describes a category in the audit log
+func categoryAuditValue(category types.Category) string {
+	return fmt.Sprintf("name=%q index=%d", category.Name, category.Index)
+}
+
 // FollowTopic implements types.MsgServer.
 func (ms msgServer) FollowTopic(goCtx context.Context, msg *types.MsgFollowTopicRequest) (*types.MsgFollowTopicResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -1800,38 +2326,31 @@ func (ms msgServer) UnfollowTopic(goCtx context.Context, msg *types.MsgUnfollowT
 func (ms msgServer) ClassifyUncategorizedTopic(goCtx context.Context, msg *types.ClassifyUncategorizedTopicRequest) (*types.ClassifyUncategorizedTopicResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	creator := msg.Creator
-	profile, _ := ms.k.ProfileKeeper.GetProfile(ctx, creator)
-	adminLevel := profile.AdminLevel
-	if adminLevel > 0 {
-		operator, _ := ms.k.GetCategoryOperator(ctx)
-		if operator != "" {
-			operatorProfile, _ := ms.k.ProfileKeeper.GetProfile(ctx, operator)
-			if adminLevel < 4 && operatorProfile.AdminLevel > adminLevel {
-				return nil, types.ErrRequestDenied
-			}
-			topic, _ := ms.k.GetTopic(ctx, msg.TopicId)
-			ms.addToCategoryTopics(ctx, msg.CategoryId, topic)
-			ms.k.RemoveFromUncategorizedTopics(ctx, msg.TopicId)
-			ms.k.SetCategoryOperator(ctx, creator)
-			ms.k.SetTopicCategoryMapping(ctx, msg.TopicId, msg.CategoryId)
-		} else {
-			topic, _ := ms.k.GetTopic(ctx, msg.TopicId)
-			ms.addToCategoryTopics(ctx, msg.CategoryId, topic)
-			ms.k.RemoveFromUncategorizedTopics(ctx, msg.TopicId)
-			ms.k.SetCategoryOperator(ctx, creator)
-			ms.k.SetTopicCategoryMapping(ctx, msg.TopicId, msg.CategoryId)
+	if err := ms.requirePermission(ctx, creator, profiletypes.Permission_PERMISSION_EDIT_TOPICS); err != nil {
+		return nil, err
+	}
+	// a lower level editor cannot override the last classification of a
+	// higher level one
+	if operator, _ := ms.k.GetCategoryOperator(ctx); operator != "" {
+		profile, _ := ms.k.ProfileKeeper.GetProfile(ctx, creator)
+		operatorProfile, _ := ms.k.ProfileKeeper.GetProfile(ctx, operator)
+		if profile.AdminLevel < 4 && operatorProfile.AdminLevel > profile.AdminLevel {
+			return nil, types.ErrRequestDenied
 		}
 	}
+	topic, _ := ms.k.GetTopic(ctx, msg.TopicId)
+	ms.addToCategoryTopics(ctx, msg.CategoryId, topic)
+	ms.k.RemoveFromUncategorizedTopics(ctx, msg.TopicId)
+	ms.k.SetCategoryOperator(ctx, creator)
+	ms.k.SetTopicCategoryMapping(ctx, msg.TopicId, msg.CategoryId)
+	ms.k.ProfileKeeper.AppendAuditLog(ctx, creator, profiletypes.AuditAction_AUDIT_ACTION_CLASSIFY_TOPIC, msg.TopicId, topic.CategoryId, msg.CategoryId)
 	return &types.ClassifyUncategorizedTopicResponse{Status: true}, nil
 }
 
 // AdminUpdateTopicCategory updates the category assignment of multiple topics (batch operation).
 // This is an administrative operation for managing topic categorization.
 //
-// PERMISSIONS:
-//   - Current: Open to all users (for development/testing)
-//   - Planned: Admin-only (adminLevel > 0)
-//   - TODO: Uncomment admin permission check before production release
+// The creator needs the edit topics permission.
 //
 // This method handles batch category management scenarios:
 //   - Assigning multiple topics to a category
@@ -1840,12 +2359,10 @@ func (ms msgServer) ClassifyUncategorizedTopic(goCtx context.Context, msg *types
 func (ms msgServer) AdminUpdateTopicCategory(goCtx context.Context, msg *types.AdminUpdateTopicCategoryRequest) (*types.AdminUpdateTopicCategoryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO(SECURITY): Uncomment this block when ready to enforce admin-only access
 	creator := msg.Creator
-	//profile, exists := ms.k.ProfileKeeper.GetProfile(ctx, creator)
-	//if !exists || profile.AdminLevel == 0 {
-	//	return nil, types.ErrRequestDenied
-	//}
+	if err := ms.requirePermission(ctx, creator, profiletypes.Permission_PERMISSION_EDIT_TOPICS); err != nil {
+		return nil, err
+	}
 
 	// Step 1: Validate input parameters
 	topicIDs := msg.TopicIds
@@ -1938,6 +2455,7 @@ func (ms msgServer) AdminUpdateTopicCategory(goCtx context.Context, msg *types.A
 
 		// Save updated topic
 		ms.k.AddTopic(ctx, topic)
+		ms.k.ProfileKeeper.AppendAuditLog(ctx, creator, profiletypes.AuditAction_AUDIT_ACTION_UPDATE_TOPIC_CATEGORY, topicID, oldCategoryID, newCategoryID)
 
 		successCount++
 	}
@@ -1962,3 +2480,962 @@ func (ms msgServer) AdminUpdateTopicCategory(goCtx context.Context, msg *types.A
 		FailedTopics: failedTopics,
 	}, nil
 }
+
+// DeletePost implements types.MsgServer.
+// It removes the post and every secondary index entry that references it, its
+// comments are queued and removed by the EndBlocker.
+func (ms msgServer) DeletePost(goCtx context.Context, msg *types.MsgDeletePostRequest) (*types.MsgDeletePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAddress(msg.Creator); err != nil {
+		return nil, err
+	}
+
+	post, err := ms.getPostWithValidation(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Creator != msg.Creator {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "only the creator can delete post %s", post.Id)
+	}
+	if err := ms.deletePost(ctx, post); err != nil {
+		return nil, err
+	}
+	return &types.MsgDeletePostResponse{Status: true}, nil
+}
+
+// deletePost removes a post together with its index entries, reactions and
+// reports. The comments of a post, or the replies of a comment, are queued
+// and removed by the EndBlocker so the cost of a deletion stays bounded.
+func (ms msgServer) deletePost(ctx sdk.Context, post types.Post) error {
+	// feeds, their counters and pins
+	ms.removeFromFeeds(ctx, post)
+	ms.unpinPost(ctx, post)
+	for _, reposter := range ms.k.GetRepostersByPostId(ctx, post.Id) {
+		if repost, found := ms.k.GetRepost(ctx, reposter, post.Id); found {
+			ms.removeRepost(ctx, repost)
+		}
+	}
+	ms.k.DeletePostTopicsMapping(ctx, post.Id)
+	ms.k.DeletePostCategoryMapping(ctx, post.Id)
+	ms.k.DeletePostReports(ctx, post.Id)
+
+	// comments are removed with their replies
+	if post.PostType == types.PostType_COMMENT {
+		if _, err := ms.deleteCommentThread(ctx, post); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDeletePost{
+			PostId:    post.Id,
+			Creator:   post.Creator,
+			ParentId:  post.ParentId,
+			Timestamp: ctx.BlockTime().Unix(),
+		}); err != nil {
+			return err
+		}
+		return nil
+	}
+	ms.queueComments(ctx, post)
+
+	// quote
+	if post.PostType == types.PostType_QUOTE && post.Quote != "" {
+		if quotedPost, found := ms.k.GetPost(ctx, post.Quote); found && quotedPost.RepostCount > 0 {
+			quotedPost.RepostCount -= 1
+			ms.k.SetPost(ctx, quotedPost)
+		}
+	}
+
+	// likes and saves
+	if err := ms.removeReactions(ctx, post); err != nil {
+		return err
+	}
+
+	// poll votes, revisions and tx hash. The paid image is kept, its id is the
+	// hash of its content and other posts may have uploaded the same image.
+	ms.k.DeletePostRevisions(ctx, post.Id)
+	if post.Poll != nil {
+		ms.k.DeletePollVotes(ctx, post.Id)
+		ms.k.DeletePollCloseQueue(ctx, post.Poll.VotingEnd, post.Id)
+		ms.k.DeletePollNotifyQueue(ctx, post.Id)
+	}
+	ms.k.DeletePostTxHashMapping(ctx, post.Id)
+
+	ms.k.DeletePost(ctx, post.Id)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDeletePost{
+		PostId:    post.Id,
+		Creator:   post.Creator,
+		ParentId:  post.ParentId,
+		Timestamp: ctx.BlockTime().Unix(),
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// removeReactions drops the likes and saves of a post from the lists of the
+// users who made them and of its creator.
+func (ms msgServer) removeReactions(ctx sdk.Context, post types.Post) error {
+	received, err := ms.k.RemoveLikesReceivedByPostId(ctx, post.Creator, post.Id)
+	if err != nil {
+		return err
+	}
+	for _, r := range received {
+		if r.LikeType == types.LikeType_SAVE {
+			_ = ms.k.RemoveFromSavesIMade(ctx, r.LikerAddress, post.Id)
+			ms.k.UnmarkUserSavedPost(ctx, r.LikerAddress, post.Id)
+		} else {
+			_ = ms.k.RemoveFromLikesIMade(ctx, r.LikerAddress, post.Id)
+			ms.k.UnmarkUserLikedPost(ctx, r.LikerAddress, post.Id)
+		}
+	}
+	return nil
+}
+
+// EditPost implements types.MsgServer.
+// The replaced version is kept as a PostRevision and the post is re-indexed under its new topics.
+func (ms msgServer) EditPost(goCtx context.Context, msg *types.MsgEditPostRequest) (*types.MsgEditPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAddress(msg.Creator); err != nil {
+		return nil, err
+	}
+
+	post, err := ms.getActivePost(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Creator != msg.Creator {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "only the creator can edit post %s", post.Id)
+	}
+	if post.PostType == types.PostType_COMMENT {
+		return nil, types.NewInvalidRequestError("cannot edit a comment type post")
+	}
+
+	// Validate params
+	if msg.Title != "" {
+		if err := types.ValidateTitle(msg.Title); err != nil {
+			return nil, err
+		}
+		if err := types.ValidatePostWithTitleContent(msg.Content); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := types.ValidatePostContent(msg.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := types.ValidateMentions(msg.Mention); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateTopics(msg.Topic); err != nil {
+		return nil, err
+	}
+	if msg.Category != "" {
+		if err := types.ValidateCategory(msg.Category); err != nil {
+			return nil, err
+		}
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	// keep the current version as a revision
+	count, _ := ms.k.GetPostRevisionCount(ctx, post.Id)
+	count += 1
+	publishedAt := post.Timestamp
+	if post.EditedTimestamp > 0 {
+		publishedAt = post.EditedTimestamp
+	}
+	ms.k.AddPostRevision(ctx, types.PostRevision{
+		PostId:     post.Id,
+		Revision:   uint64(count),
+		Title:      post.Title,
+		Content:    post.Content,
+		Timestamp:  publishedAt,
+		ReplacedAt: blockTime,
+	})
+	ms.k.SetPostRevisionCount(ctx, post.Id, count)
+
+	// remove the post from its old topic and category feeds, they are rebuilt below
+	for _, topicHash := range ms.k.GetTopicsByPostId(ctx, post.Id) {
+		ms.removeFromTopicPosts(ctx, topicHash, post)
+	}
+	if category := ms.k.GetCategoryByPostId(ctx, post.Id); category != "" {
+		ms.removeFromCategoryPosts(ctx, category, post)
+	}
+	ms.k.DeletePostTopicsMapping(ctx, post.Id)
+	ms.k.DeletePostCategoryMapping(ctx, post.Id)
+
+	if ms.k.IsPostInHomePosts(ctx, post.Id, post.HomePostsUpdate) {
+		ms.updateHomePosts(ctx, post)
+	}
+
+	// update post
+	post.Title = msg.Title
+	post.Content = msg.Content
+	post.EditedTimestamp = blockTime
+	post.HomePostsUpdate = blockTime
+	if post.PostType == types.PostType_ORIGINAL || post.PostType == types.PostType_ARTICLE {
+		if msg.Title != "" {
+			post.PostType = types.PostType_ARTICLE
+		} else {
+			post.PostType = types.PostType_ORIGINAL
+		}
+	}
+	// mentions add to activitiesReceived, only the new ones are notified
+	post.Mentions = ms.notifyMentions(ctx, post, msg.Creator, msg.Mention, post.Mentions)
+	ms.k.SetPost(ctx, post)
+
+	err = ms.handleCategoryTopicPost(ctx, msg.Creator, msg.Topic, msg.Category, blockTime, post.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditPost,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+			sdk.NewAttribute(types.AttributeKeyTitle, msg.Title),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", blockTime)),
+		),
+	})
+
+	return &types.MsgEditPostResponse{Status: true}, nil
+}
+
+// EditComment implements types.MsgServer.
+// The replaced text is kept as a PostRevision and the comment activity of the parent creator is updated.
+func (ms msgServer) EditComment(goCtx context.Context, msg *types.MsgEditCommentRequest) (*types.MsgEditCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAddress(msg.Creator); err != nil {
+		return nil, err
+	}
+	if len(msg.Comment) == 0 {
+		return nil, types.NewInvalidRequestError("comment cannot be empty")
+	}
+
+	comment, err := ms.getActivePost(ctx, msg.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.PostType != types.PostType_COMMENT {
+		return nil, types.WrapErrorf(types.ErrInvalidRequest, "post %s is not a comment", comment.Id)
+	}
+	if comment.Creator != msg.Creator {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "only the creator can edit comment %s", comment.Id)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	// keep the current version as a revision
+	count, _ := ms.k.GetPostRevisionCount(ctx, comment.Id)
+	count += 1
+	publishedAt := comment.Timestamp
+	if comment.EditedTimestamp > 0 {
+		publishedAt = comment.EditedTimestamp
+	}
+	ms.k.AddPostRevision(ctx, types.PostRevision{
+		PostId:     comment.Id,
+		Revision:   uint64(count),
+		Content:    comment.Content,
+		Timestamp:  publishedAt,
+		ReplacedAt: blockTime,
+	})
+	ms.k.SetPostRevisionCount(ctx, comment.Id, count)
+
+	comment.Content = msg.Comment
+	comment.EditedTimestamp = blockTime
+	ms.k.SetPost(ctx, comment)
+
+	// the activity shown to the parent creator carries the comment text
+	if parent, found := ms.k.GetPost(ctx, comment.ParentId); found {
+		activity, found := ms.k.ProfileKeeper.GetActivityReceived(ctx, parent.Creator, comment.Timestamp, profiletypes.ActivitiesType_ACTIVITIES_COMMENT, comment.Creator)
+		if found && activity.CommentId == comment.Id {
+			activity.Content = comment.Content
+			ms.k.ProfileKeeper.SetActivitiesReceived(atTime(ctx, comment.Timestamp), activity, parent.Creator, comment.Creator)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditComment,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyCommentID, comment.Id),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", blockTime)),
+		),
+	})
+
+	return &types.MsgEditCommentResponse{Status: true}, nil
+}
+
+// DeleteComment implements types.MsgServer.
+// The comment is removed together with its replies, by its creator or by the
+// creator of the post or comment it is under.
+func (ms msgServer) DeleteComment(goCtx context.Context, msg *types.MsgDeleteCommentRequest) (*types.MsgDeleteCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAddress(msg.Creator); err != nil {
+		return nil, err
+	}
+
+	comment, err := ms.getPostWithValidation(ctx, msg.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.PostType != types.PostType_COMMENT {
+		return nil, types.WrapErrorf(types.ErrInvalidRequest, "post %s is not a comment", comment.Id)
+	}
+	if !ms.canDeleteComment(ctx, comment, msg.Creator) {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "%s cannot delete comment %s", msg.Creator, comment.Id)
+	}
+
+	removed, err := ms.deleteCommentThread(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDeleteComment{
+		CommentId: comment.Id,
+		Creator:   comment.Creator,
+		DeletedBy: msg.Creator,
+		ParentId:  comment.ParentId,
+		Removed:   removed,
+		Timestamp: ctx.BlockTime().Unix(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteCommentResponse{Status: true}, nil
+}
+
+// canDeleteComment reports whether address created the comment, the post or
+// comment it replies to, or the post its thread hangs from.
+func (ms msgServer) canDeleteComment(ctx sdk.Context, comment types.Post, address string) bool {
+	if comment.Creator == address {
+		return true
+	}
+	if parent, found := ms.k.GetPost(ctx, comment.ParentId); found && parent.Creator == address {
+		return true
+	}
+	if comment.RootId != "" && comment.RootId != comment.ParentId {
+		if root, found := ms.k.GetPost(ctx, comment.RootId); found && root.Creator == address {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteCommentThread removes a comment, queues its replies and lowers the
+// comment count of every post above it. It returns how many comments the
+// thread held.
+func (ms msgServer) deleteCommentThread(ctx sdk.Context, comment types.Post) (uint64, error) {
+	parent, found := ms.k.GetPost(ctx, comment.ParentId)
+	if err := ms.removeComment(ctx, comment, parent.Creator); err != nil {
+		return 0, err
+	}
+	removed := 1 + comment.CommentCount
+	if !found {
+		return removed, nil
+	}
+
+	// the removed comments were counted by every post above them
+	for ancestor := parent; ; {
+		if ancestor.CommentCount > removed {
+			ancestor.CommentCount -= removed
+		} else {
+			ancestor.CommentCount = 0
+		}
+		ms.k.SetPost(ctx, ancestor)
+		if ancestor.PostType != types.PostType_COMMENT {
+			break
+		}
+		next, found := ms.k.GetPost(ctx, ancestor.ParentId)
+		if !found {
+			break
+		}
+		ancestor = next
+	}
+	return removed, nil
+}
+
+// removeComment deletes a comment and every index entry that references it,
+// its replies are queued. parentCreator is the creator of the post the comment
+// replies to.
+func (ms msgServer) removeComment(ctx sdk.Context, comment types.Post, parentCreator string) error {
+	ms.queueComments(ctx, comment)
+	ms.k.DeleteFromCommentList(ctx, comment.ParentId, comment.Id, comment.Score)
+
+	if parentCreator != "" {
+		ms.k.DeleteFromCommentsReceived(ctx, parentCreator, comment.Id, comment.Timestamp)
+		activity, found := ms.k.ProfileKeeper.GetActivityReceived(ctx, parentCreator, comment.Timestamp, profiletypes.ActivitiesType_ACTIVITIES_COMMENT, comment.Creator)
+		if found && activity.CommentId == comment.Id {
+			ms.k.ProfileKeeper.DeleteActivityReceived(ctx, parentCreator, comment.Timestamp, profiletypes.ActivitiesType_ACTIVITIES_COMMENT, comment.Creator)
+		}
+	}
+
+	if err := ms.removeReactions(ctx, comment); err != nil {
+		return err
+	}
+	ms.k.DeletePostRevisions(ctx, comment.Id)
+	ms.k.DeletePostReports(ctx, comment.Id)
+	ms.k.DeletePostTxHashMapping(ctx, comment.Id)
+	ms.k.DeletePost(ctx, comment.Id)
+	return nil
+}
+
+// queueComments queues the comments listed under post for the EndBlocker.
+func (ms msgServer) queueComments(ctx sdk.Context, post types.Post) {
+	if ms.k.HasComments(ctx, post.Id) {
+		ms.k.AddToCommentDeleteQueue(ctx, post.Id, post.Creator)
+	}
+}
+
+// AddPostLabels implements types.MsgServer.
+func (ms msgServer) AddPostLabels(goCtx context.Context, msg *types.MsgAddPostLabelsRequest) (*types.MsgAddPostLabelsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.IsModerator(ctx, msg.Creator) {
+		return nil, types.ErrRequestDenied
+	}
+	post, err := ms.getPostWithValidation(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.addPostLabels(ctx, post, msg.Labels, msg.Creator); err != nil {
+		return nil, err
+	}
+	return &types.MsgAddPostLabelsResponse{Status: true}, nil
+}
+
+// addPostLabels adds the labels a moderator set to the labels of a post.
+func (ms msgServer) addPostLabels(ctx sdk.Context, post types.Post, labels []string, sender string) error {
+	if len(labels) == 0 {
+		return types.NewInvalidRequestError("labels cannot be empty")
+	}
+	if err := types.ValidateLabels(labels); err != nil {
+		return err
+	}
+	for _, label := range labels {
+		if !slices.Contains(post.Labels, label) {
+			post.Labels = append(post.Labels, label)
+		}
+	}
+	ms.k.SetPost(ctx, post)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddPostLabels,
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+		sdk.NewAttribute(types.AttributeKeyLabels, strings.Join(post.Labels, ",")),
+	))
+	return nil
+}
+
+// ReportPost implements types.MsgServer.
+func (ms msgServer) ReportPost(goCtx context.Context, msg *types.MsgReportPostRequest) (*types.MsgReportPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.validateAddress(msg.Creator); err != nil {
+		return nil, err
+	}
+	if _, ok := types.ReportReason_name[int32(msg.Reason)]; !ok || msg.Reason == types.ReportReason_REPORT_REASON_UNSPECIFIED {
+		return nil, types.NewInvalidRequestErrorf("invalid report reason %d", msg.Reason)
+	}
+	if len(msg.Note) > types.MaxReportNoteLength {
+		return nil, types.NewContentTooLongError(len(msg.Note), types.MaxReportNoteLength)
+	}
+	post, err := ms.getActivePost(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Creator == msg.Creator {
+		return nil, types.NewInvalidRequestError("cannot report your own post")
+	}
+	if ms.k.HasReport(ctx, post.Id, msg.Creator) {
+		return nil, types.ErrAlreadyReported
+	}
+
+	ms.addReport(ctx, types.Report{
+		PostId:    post.Id,
+		Reporter:  msg.Creator,
+		Reason:    msg.Reason,
+		Note:      msg.Note,
+		Timestamp: ctx.BlockTime().Unix(),
+	})
+	return &types.MsgReportPostResponse{Status: true}, nil
+}
+
+// addReport stores a report and queues the post on its first open report.
+func (ms msgServer) addReport(ctx sdk.Context, report types.Report) {
+	count := ms.k.GetReportCount(ctx, report.PostId)
+	if count == 0 {
+		ms.k.AddToReportQueue(ctx, report.Timestamp, report.PostId)
+	}
+	ms.k.SetReport(ctx, report)
+	ms.k.SetReportCount(ctx, report.PostId, count+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReportPost,
+		sdk.NewAttribute(types.AttributeKeySender, report.Reporter),
+		sdk.NewAttribute(types.AttributeKeyPostID, report.PostId),
+		sdk.NewAttribute(types.AttributeKeyReason, report.Reason.String()),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", report.Timestamp)),
+	))
+}
+
+// ResolveReport implements types.MsgServer.
+func (ms msgServer) ResolveReport(goCtx context.Context, msg *types.MsgResolveReportRequest) (*types.MsgResolveReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.IsModerator(ctx, msg.Creator) {
+		return nil, types.ErrRequestDenied
+	}
+	post, err := ms.getPostWithValidation(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	count := ms.k.GetReportCount(ctx, post.Id)
+	if count == 0 {
+		return nil, types.NewResourceNotFoundErrorf("post %s has no open reports", post.Id)
+	}
+
+	switch msg.Outcome {
+	case types.ReportOutcome_REPORT_OUTCOME_DISMISS:
+	case types.ReportOutcome_REPORT_OUTCOME_LABEL:
+		if err := ms.addPostLabels(ctx, post, msg.Labels, msg.Creator); err != nil {
+			return nil, err
+		}
+	case types.ReportOutcome_REPORT_OUTCOME_TAKE_DOWN:
+		if err := ms.takedownPost(ctx, post, msg.Creator, "reported by users"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, types.NewInvalidRequestErrorf("invalid report outcome %d", msg.Outcome)
+	}
+	ms.k.DeletePostReports(ctx, post.Id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResolveReport,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+		sdk.NewAttribute(types.AttributeKeyOutcome, msg.Outcome.String()),
+		sdk.NewAttribute(types.AttributeKeyReportCount, fmt.Sprintf("%d", count)),
+	))
+	return &types.MsgResolveReportResponse{Status: true}, nil
+}
+
+// TakedownPost implements types.MsgServer.
+func (ms msgServer) TakedownPost(goCtx context.Context, msg *types.MsgTakedownPostRequest) (*types.MsgTakedownPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.IsModerator(ctx, msg.Creator) {
+		return nil, types.ErrRequestDenied
+	}
+	if strings.TrimSpace(msg.Reason) == "" {
+		return nil, types.NewInvalidRequestError("takedown reason cannot be empty")
+	}
+	if len(msg.Reason) > types.MaxTakedownReasonLength {
+		return nil, types.NewContentTooLongError(len(msg.Reason), types.MaxTakedownReasonLength)
+	}
+	post, err := ms.getPostWithValidation(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.takedownPost(ctx, post, msg.Creator, msg.Reason); err != nil {
+		return nil, err
+	}
+	return &types.MsgTakedownPostResponse{Status: true}, nil
+}
+
+// takedownPost hides a post from every feed and records the takedown on it.
+func (ms msgServer) takedownPost(ctx sdk.Context, post types.Post, moderator string, reason string) error {
+	if post.TakedownStatus != types.TakedownStatus_TAKEDOWN_STATUS_NONE {
+		return types.NewInvalidRequestErrorf("post %s is already taken down", post.Id)
+	}
+	ms.hidePost(ctx, post)
+
+	blockTime := ctx.BlockTime().Unix()
+	moderatorProfile, _ := ms.k.ProfileKeeper.GetProfile(ctx, moderator)
+	post.TakedownStatus = types.TakedownStatus_TAKEDOWN_STATUS_TAKEN_DOWN
+	post.Takedown = &types.Takedown{
+		Moderator:      moderator,
+		ModeratorLevel: moderatorProfile.AdminLevel,
+		Reason:         reason,
+		Timestamp:      blockTime,
+	}
+	ms.k.SetPost(ctx, post)
+	ms.addActivitiesReceived(ctx, post, "", reason, moderator, post.Creator, profiletypes.ActivitiesType_ACTIVITIES_TAKEDOWN)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTakedownPost,
+		sdk.NewAttribute(types.AttributeKeySender, moderator),
+		sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+		sdk.NewAttribute(types.AttributeKeyCreator, post.Creator),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", blockTime)),
+	))
+	return nil
+}
+
+// AppealTakedown implements types.MsgServer.
+func (ms msgServer) AppealTakedown(goCtx context.Context, msg *types.MsgAppealTakedownRequest) (*types.MsgAppealTakedownResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	post, err := ms.getPostWithValidation(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Creator != msg.Creator {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "only the creator can appeal the takedown of post %s", post.Id)
+	}
+	if post.TakedownStatus != types.TakedownStatus_TAKEDOWN_STATUS_TAKEN_DOWN || post.Takedown.AppealTimestamp != 0 {
+		return nil, types.NewInvalidRequestErrorf("post %s has no takedown open to appeal", post.Id)
+	}
+	if strings.TrimSpace(msg.Appeal) == "" {
+		return nil, types.NewInvalidRequestError("appeal cannot be empty")
+	}
+	if len(msg.Appeal) > types.MaxTakedownReasonLength {
+		return nil, types.NewContentTooLongError(len(msg.Appeal), types.MaxTakedownReasonLength)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	post.TakedownStatus = types.TakedownStatus_TAKEDOWN_STATUS_APPEALED
+	post.Takedown.Appeal = msg.Appeal
+	post.Takedown.AppealTimestamp = blockTime
+	ms.k.SetPost(ctx, post)
+	ms.addActivitiesReceived(ctx, post, "", msg.Appeal, msg.Creator, post.Takedown.Moderator, profiletypes.ActivitiesType_ACTIVITIES_TAKEDOWN_APPEAL)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAppealTakedown,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", blockTime)),
+	))
+	return &types.MsgAppealTakedownResponse{Status: true}, nil
+}
+
+// DecideAppeal implements types.MsgServer.
+func (ms msgServer) DecideAppeal(goCtx context.Context, msg *types.MsgDecideAppealRequest) (*types.MsgDecideAppealResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	post, err := ms.getPostWithValidation(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.TakedownStatus != types.TakedownStatus_TAKEDOWN_STATUS_APPEALED {
+		return nil, types.NewInvalidRequestErrorf("post %s has no pending appeal", post.Id)
+	}
+	if !ms.k.IsModerator(ctx, msg.Creator) {
+		return nil, types.ErrRequestDenied
+	}
+	// super admins may decide any appeal, other admins only the takedowns of
+	// lower level moderators
+	profile, _ := ms.k.ProfileKeeper.GetProfile(ctx, msg.Creator)
+	outranks := profile.AdminLevel > post.Takedown.ModeratorLevel ||
+		ms.k.ProfileKeeper.HasPermission(ctx, msg.Creator, profiletypes.Permission_PERMISSION_MANAGE_ADMINS)
+	if msg.Creator == post.Takedown.Moderator || !outranks {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "the appeal must be decided by an admin above level %d", post.Takedown.ModeratorLevel)
+	}
+	if len(msg.Note) > types.MaxTakedownReasonLength {
+		return nil, types.NewContentTooLongError(len(msg.Note), types.MaxTakedownReasonLength)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	post.Takedown.DecidedBy = msg.Creator
+	post.Takedown.Restored = msg.Restore
+	post.Takedown.DecisionNote = msg.Note
+	post.Takedown.DecisionTimestamp = blockTime
+	if msg.Restore {
+		post.TakedownStatus = types.TakedownStatus_TAKEDOWN_STATUS_NONE
+		ms.unhidePost(ctx, post)
+	} else {
+		post.TakedownStatus = types.TakedownStatus_TAKEDOWN_STATUS_APPEAL_REJECTED
+	}
+	ms.k.SetPost(ctx, post)
+	ms.addActivitiesReceived(ctx, post, "", msg.Note, msg.Creator, post.Creator, profiletypes.ActivitiesType_ACTIVITIES_APPEAL_DECISION)
+	ms.addActivitiesReceived(ctx, post, "", msg.Note, msg.Creator, post.Takedown.Moderator, profiletypes.ActivitiesType_ACTIVITIES_APPEAL_DECISION)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDecideAppeal,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+		sdk.NewAttribute(types.AttributeKeyRestored, fmt.Sprintf("%t", msg.Restore)),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", blockTime)),
+	))
+	return &types.MsgDecideAppealResponse{Status: true}, nil
+}
+
+// hidePost takes a post out of the feeds and comment lists it is listed in,
+// reposts included, while keeping the records needed by unhidePost.
+func (ms msgServer) hidePost(ctx sdk.Context, post types.Post) {
+	if post.PostType == types.PostType_COMMENT {
+		ms.k.DeleteFromCommentList(ctx, post.ParentId, post.Id, post.Score)
+		return
+	}
+	ms.removeFromFeeds(ctx, post)
+	ms.unpinPost(ctx, post)
+	for _, reposter := range ms.k.GetRepostersByPostId(ctx, post.Id) {
+		ms.removeFromUserCreatedPosts(ctx, reposter, post.Id)
+	}
+}
+
+// unpinPost drops the post from the profile and topic pins it is listed in.
+func (ms msgServer) unpinPost(ctx sdk.Context, post types.Post) {
+	ms.k.DeleteUserPin(ctx, post.Creator, post.Id)
+	for _, topicHash := range ms.k.GetTopicsByPostId(ctx, post.Id) {
+		ms.k.DeleteTopicPin(ctx, topicHash, post.Id)
+	}
+}
+
+// unhidePost puts a post back at its original position in the feeds hidePost
+// took it out of.
+func (ms msgServer) unhidePost(ctx sdk.Context, post types.Post) {
+	if post.PostType == types.PostType_COMMENT {
+		ms.k.AddToCommentList(ctx, post.ParentId, post.Id, post.Score)
+		return
+	}
+	if !ms.k.IsOutOfPublicFeeds(ctx, post) {
+		ms.addToPublicFeeds(ctx, post)
+	}
+	ms.addToUserCreatedPosts(atTime(ctx, post.Timestamp), post.Creator, post)
+	for _, reposter := range ms.k.GetRepostersByPostId(ctx, post.Id) {
+		if repost, found := ms.k.GetRepost(ctx, reposter, post.Id); found {
+			ms.addToUserCreatedPosts(atTime(ctx, repost.Timestamp), reposter, post)
+		}
+	}
+}
+
+// reindexCreatorPosts moves the listed posts of creator in or out of the public
+// feeds after the account changed its privacy.
+func (ms msgServer) reindexCreatorPosts(ctx sdk.Context, creator string) {
+	for _, postId := range ms.k.GetUserCreatedPostIds(ctx, creator) {
+		post, found := ms.k.GetPost(ctx, postId)
+		if !found || post.Creator != creator || post.TakedownStatus != types.TakedownStatus_TAKEDOWN_STATUS_NONE {
+			continue
+		}
+		if ms.k.IsOutOfPublicFeeds(ctx, post) {
+			ms.removeFromPublicFeeds(ctx, post)
+		} else {
+			ms.addToPublicFeeds(ctx, post)
+		}
+	}
+}
+
+// Helper function to check that address may moderate the topic, either as its
+// admin or as a holder of the topic editing permission
+func (ms msgServer) canModerateTopic(ctx sdk.Context, topic types.Topic, address string) error {
+	if topic.TopicAdmin != "" && topic.TopicAdmin == address {
+		return nil
+	}
+	if ms.k.ProfileKeeper.HasPermission(ctx, address, profiletypes.Permission_PERMISSION_EDIT_TOPICS) {
+		return nil
+	}
+	return types.WrapErrorf(types.ErrRequestDenied, "%s does not moderate topic %s", address, topic.Id)
+}
+
+// Helper function to get a topic and check that sender may moderate it
+func (ms msgServer) getModeratedTopic(ctx sdk.Context, topicHash string, sender string) (types.Topic, error) {
+	topic, found := ms.k.GetTopic(ctx, topicHash)
+	if !found {
+		return types.Topic{}, types.NewTopicNotFoundError(topicHash)
+	}
+	if err := ms.canModerateTopic(ctx, topic, sender); err != nil {
+		return types.Topic{}, err
+	}
+	return topic, nil
+}
+
+// ClaimTopic implements types.MsgServer.
+func (ms msgServer) ClaimTopic(goCtx context.Context, msg *types.MsgClaimTopicRequest) (*types.MsgClaimTopicResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	topic, found := ms.k.GetTopic(ctx, msg.TopicId)
+	if !found {
+		return nil, types.NewTopicNotFoundError(msg.TopicId)
+	}
+	if topic.TopicAdmin != "" {
+		return nil, types.NewInvalidRequestErrorf("topic %s already has an admin", topic.Id)
+	}
+	if topic.Creator != msg.Creator {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "only the creator of topic %s can claim it", topic.Id)
+	}
+	topic.TopicAdmin = msg.Creator
+	ms.k.AddTopic(ctx, topic)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClaimTopic,
+		sdk.NewAttribute(types.AttributeKeyTopicID, topic.Id),
+		sdk.NewAttribute(types.AttributeKeyAdmin, msg.Creator),
+	))
+	return &types.MsgClaimTopicResponse{Status: true}, nil
+}
+
+// SetTopicAdmin implements types.MsgServer.
+func (ms msgServer) SetTopicAdmin(goCtx context.Context, msg *types.MsgSetTopicAdminRequest) (*types.MsgSetTopicAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, types.NewInvalidRequestErrorf("invalid admin address %s", msg.Admin)
+		}
+	}
+	topic, err := ms.getModeratedTopic(ctx, msg.TopicId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	topic.TopicAdmin = msg.Admin
+	ms.k.AddTopic(ctx, topic)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetTopicAdmin,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyTopicID, topic.Id),
+		sdk.NewAttribute(types.AttributeKeyAdmin, msg.Admin),
+	))
+	return &types.MsgSetTopicAdminResponse{Status: true}, nil
+}
+
+// PinTopicPost implements types.MsgServer.
+func (ms msgServer) PinTopicPost(goCtx context.Context, msg *types.MsgPinTopicPostRequest) (*types.MsgPinTopicPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	topic, err := ms.getModeratedTopic(ctx, msg.TopicId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	post, err := ms.getActivePost(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if !ms.k.IsPostInTopicPosts(ctx, topic.Id, post.Id, post.HomePostsUpdate) {
+		return nil, types.NewInvalidRequestErrorf("post %s is not in topic %s", post.Id, topic.Id)
+	}
+	if ms.k.IsTopicPin(ctx, topic.Id, post.Id) {
+		return nil, types.NewInvalidRequestErrorf("post %s is already pinned", post.Id)
+	}
+	if len(ms.k.GetTopicPins(ctx, topic.Id)) >= types.MaxTopicPinnedPosts {
+		return nil, types.NewInvalidRequestErrorf("a topic can pin at most %d posts", types.MaxTopicPinnedPosts)
+	}
+	ms.k.SetTopicPin(ctx, topic.Id, post.Id, ctx.BlockTime().Unix())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePinTopicPost,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyTopicID, topic.Id),
+		sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+	))
+	return &types.MsgPinTopicPostResponse{Status: true}, nil
+}
+
+// UnpinTopicPost implements types.MsgServer.
+func (ms msgServer) UnpinTopicPost(goCtx context.Context, msg *types.MsgUnpinTopicPostRequest) (*types.MsgUnpinTopicPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	topic, err := ms.getModeratedTopic(ctx, msg.TopicId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if !ms.k.IsTopicPin(ctx, topic.Id, msg.PostId) {
+		return nil, types.NewInvalidRequestErrorf("post %s is not pinned", msg.PostId)
+	}
+	ms.k.DeleteTopicPin(ctx, topic.Id, msg.PostId)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnpinTopicPost,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyTopicID, topic.Id),
+		sdk.NewAttribute(types.AttributeKeyPostID, msg.PostId),
+	))
+	return &types.MsgUnpinTopicPostResponse{Status: true}, nil
+}
+
+// RemoveTopicPost implements types.MsgServer.
+func (ms msgServer) RemoveTopicPost(goCtx context.Context, msg *types.MsgRemoveTopicPostRequest) (*types.MsgRemoveTopicPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	topic, err := ms.getModeratedTopic(ctx, msg.TopicId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	post, err := ms.getPostWithValidation(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	var remaining []string
+	inTopic := false
+	for _, topicHash := range ms.k.GetTopicsByPostId(ctx, post.Id) {
+		if topicHash == topic.Id {
+			inTopic = true
+			continue
+		}
+		remaining = append(remaining, topicHash)
+	}
+	if !inTopic {
+		return nil, types.NewInvalidRequestErrorf("post %s is not in topic %s", post.Id, topic.Id)
+	}
+	ms.removeFromTopicPosts(ctx, topic.Id, post)
+	// drop the mapping as well so a restored post does not come back to the topic
+	if len(remaining) == 0 {
+		ms.k.DeletePostTopicsMapping(ctx, post.Id)
+	} else {
+		ms.k.SetPostTopicsMapping(ctx, remaining, post.Id)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveTopicPost,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyTopicID, topic.Id),
+		sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+	))
+	return &types.MsgRemoveTopicPostResponse{Status: true}, nil
+}
+
+// PinPost implements types.MsgServer.
+func (ms msgServer) PinPost(goCtx context.Context, msg *types.MsgPinPostRequest) (*types.MsgPinPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	post, err := ms.getActivePost(ctx, msg.PostId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Creator != msg.Creator {
+		return nil, types.WrapErrorf(types.ErrRequestDenied, "post %s is not owned by %s", post.Id, msg.Creator)
+	}
+	if post.PostType == types.PostType_COMMENT {
+		return nil, types.NewInvalidRequestError("comments can not be pinned")
+	}
+	if ms.k.IsUserPin(ctx, msg.Creator, post.Id) {
+		return nil, types.NewInvalidRequestErrorf("post %s is already pinned", post.Id)
+	}
+	maxPinned := ms.k.GetMaxPinnedPosts(ctx)
+	if len(ms.k.GetUserPins(ctx, msg.Creator)) >= maxPinned {
+		return nil, types.NewInvalidRequestErrorf("at most %d posts can be pinned", maxPinned)
+	}
+	ms.k.SetUserPin(ctx, msg.Creator, post.Id, ctx.BlockTime().Unix())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePinPost,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPostID, post.Id),
+	))
+	return &types.MsgPinPostResponse{Status: true}, nil
+}
+
+// UnpinPost implements types.MsgServer.
+func (ms msgServer) UnpinPost(goCtx context.Context, msg *types.MsgUnpinPostRequest) (*types.MsgUnpinPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.IsUserPin(ctx, msg.Creator, msg.PostId) {
+		return nil, types.NewInvalidRequestErrorf("post %s is not pinned", msg.PostId)
+	}
+	ms.k.DeleteUserPin(ctx, msg.Creator, msg.PostId)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnpinPost,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPostID, msg.PostId),
+	))
+	return &types.MsgUnpinPostResponse{Status: true}, nil
+}
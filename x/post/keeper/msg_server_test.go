@@ -1,11 +1,16 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/rollchains/tlock/x/post/types"
+	profiletypes "github.com/rollchains/tlock/x/profile/types"
 )
 
 func TestParams(t *testing.T) {
@@ -25,6 +30,14 @@ func TestParams(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			name: "fail; too many pinned posts",
+			request: &types.MsgUpdateParams{
+				Authority: f.govModAddr,
+				Params:    types.Params{MaxPinnedPosts: types.MaxPinnedPostsLimit + 1},
+			},
+			err: true,
+		},
 		{
 			name: "success",
 			request: &types.MsgUpdateParams{
@@ -54,3 +67,1120 @@ func TestParams(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentThread(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice := f.addrs[0].String()
+
+	postId := fmt.Sprintf("%064d", 1)
+	f.k.SetPost(f.ctx, types.Post{Id: postId, PostType: types.PostType_ORIGINAL, Creator: alice, Timestamp: 1})
+
+	reply := func(parentId string, text string) string {
+		_, err := f.msgServer.Comment(f.ctx, &types.MsgCommentRequest{Creator: alice, ParentId: parentId, Comment: text})
+		require.NoError(err)
+		ids, _, _, err := f.k.GetCommentsByParentId(f.ctx, parentId, 1)
+		require.NoError(err)
+		require.NotEmpty(ids)
+		return ids[len(ids)-1]
+	}
+	c1 := reply(postId, "first")
+	c2 := reply(c1, "reply")
+	c3 := reply(c2, "nested")
+
+	// the reply is counted by every post above it
+	post, _ := f.k.GetPost(f.ctx, postId)
+	require.Equal(uint64(3), post.CommentCount)
+	comment, _ := f.k.GetPost(f.ctx, c1)
+	require.Equal(uint64(2), comment.CommentCount)
+	require.Equal(postId, comment.RootId)
+	require.Equal(uint64(1), comment.Depth)
+	comment, _ = f.k.GetPost(f.ctx, c3)
+	require.Equal(postId, comment.RootId)
+	require.Equal(uint64(3), comment.Depth)
+
+	res, err := f.queryServer.QueryCommentThread(f.ctx, &types.QueryCommentThreadRequest{Id: postId, MaxDepth: 2})
+	require.NoError(err)
+	require.Len(res.Replies, 1)
+	require.Equal(c1, res.Replies[0].Comment.Post.Id)
+	require.Len(res.Replies[0].Replies, 1)
+	require.Equal(c2, res.Replies[0].Replies[0].Comment.Post.Id)
+	require.Empty(res.Replies[0].Replies[0].Replies)
+	require.Empty(res.NextCursor)
+
+	// a second direct comment is returned through the cursor
+	reply(postId, "second")
+	res, err = f.queryServer.QueryCommentThread(f.ctx, &types.QueryCommentThreadRequest{Id: postId, Limit: 1, MaxDepth: 1})
+	require.NoError(err)
+	require.Len(res.Replies, 1)
+	require.NotEmpty(res.NextCursor)
+	first := res.Replies[0].Comment.Post.Id
+
+	res, err = f.queryServer.QueryCommentThread(f.ctx, &types.QueryCommentThreadRequest{Id: postId, Limit: 1, MaxDepth: 1, Cursor: res.NextCursor})
+	require.NoError(err)
+	require.Len(res.Replies, 1)
+	require.NotEqual(first, res.Replies[0].Comment.Post.Id)
+	require.Empty(res.NextCursor)
+}
+
+func TestEditAndDeleteComment(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice := f.addrs[0].String()
+	bob := f.addrs[1].String()
+	carol := f.addrs[2].String()
+
+	postId := fmt.Sprintf("%064d", 1)
+	f.k.SetPost(f.ctx, types.Post{Id: postId, PostType: types.PostType_ORIGINAL, Creator: alice, Timestamp: 1})
+
+	reply := func(creator string, parentId string, text string) string {
+		_, err := f.msgServer.Comment(f.ctx, &types.MsgCommentRequest{Creator: creator, ParentId: parentId, Comment: text})
+		require.NoError(err)
+		ids, _, _, err := f.k.GetCommentsByParentId(f.ctx, parentId, 1)
+		require.NoError(err)
+		require.Len(ids, 1)
+		return ids[0]
+	}
+	c1 := reply(bob, postId, "first")
+	c2 := reply(carol, c1, "reply")
+
+	// only the creator can edit
+	_, err := f.msgServer.EditComment(f.ctx, &types.MsgEditCommentRequest{Creator: alice, CommentId: c1, Comment: "changed"})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.EditComment(f.ctx, &types.MsgEditCommentRequest{Creator: bob, CommentId: c1, Comment: "changed"})
+	require.NoError(err)
+	comment, _ := f.k.GetPost(f.ctx, c1)
+	require.Equal("changed", comment.Content)
+	revisions, _, _, err := f.k.GetPostRevisions(f.ctx, c1, 1)
+	require.NoError(err)
+	require.Len(revisions, 1)
+	require.Equal("first", revisions[0].Content)
+
+	// the reply author cannot delete the comment it replies to
+	_, err = f.msgServer.DeleteComment(f.ctx, &types.MsgDeleteCommentRequest{Creator: carol, CommentId: c1})
+	require.ErrorIs(err, types.ErrRequestDenied)
+
+	// the post creator removes the comment, its reply goes in the EndBlocker
+	_, err = f.msgServer.DeleteComment(f.ctx, &types.MsgDeleteCommentRequest{Creator: alice, CommentId: c1})
+	require.NoError(err)
+	require.NoError(f.k.EndBlocker(f.ctx))
+	_, found := f.k.GetPost(f.ctx, c1)
+	require.False(found)
+	_, found = f.k.GetPost(f.ctx, c2)
+	require.False(found)
+	post, _ := f.k.GetPost(f.ctx, postId)
+	require.Equal(uint64(0), post.CommentCount)
+	ids, _, _, err := f.k.GetCommentsByParentId(f.ctx, postId, 1)
+	require.NoError(err)
+	require.Empty(ids)
+	received, _, _, err := f.k.GetCommentsReceived(f.ctx, alice, 1)
+	require.NoError(err)
+	require.Empty(received)
+}
+
+func TestDeletePostWithComments(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "hello"}})
+	require.NoError(err)
+	reply := func(creator string, parentId string, text string) string {
+		_, err := f.msgServer.Comment(ctx, &types.MsgCommentRequest{Creator: creator, ParentId: parentId, Comment: text})
+		require.NoError(err)
+		ids, _, _, err := f.k.GetCommentsByParentId(ctx, parentId, 1)
+		require.NoError(err)
+		require.Len(ids, 1)
+		return ids[0]
+	}
+	c1 := reply(bob, res.PostId, "first")
+	c2 := reply(carol, c1, "reply")
+	_, err = f.msgServer.Like(ctx, &types.MsgLikeRequest{Sender: alice, Id: c2})
+	require.NoError(err)
+
+	_, err = f.msgServer.DeletePost(ctx, &types.MsgDeletePostRequest{Creator: alice, PostId: res.PostId})
+	require.NoError(err)
+	// the comments are left to the EndBlocker, one level of the thread per block
+	_, found := f.k.GetPost(ctx, c1)
+	require.True(found)
+	for i := 0; i < 2; i++ {
+		require.NoError(f.k.EndBlocker(ctx))
+	}
+	parentIds, _ := f.k.GetCommentDeleteQueue(ctx, 10)
+	require.Empty(parentIds)
+	for _, id := range []string{res.PostId, c1, c2} {
+		_, found := f.k.GetPost(ctx, id)
+		require.False(found)
+	}
+	require.Empty(f.k.GetCommentIdsByParentId(ctx, c1))
+	require.False(f.k.HasUserLikedPost(ctx, alice, c2))
+	for _, address := range []string{alice, bob} {
+		received, _, _, err := f.k.GetCommentsReceived(ctx, address, 1)
+		require.NoError(err)
+		require.Empty(received)
+	}
+}
+
+func TestRepostAndUndoRepost(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice := f.addrs[0].String()
+	bob := f.addrs[1].String()
+
+	postId := fmt.Sprintf("%064d", 1)
+	f.k.SetPost(f.ctx, types.Post{Id: postId, PostType: types.PostType_ORIGINAL, Creator: alice, Timestamp: 1})
+
+	_, err := f.msgServer.Repost(f.ctx, &types.MsgRepostRequest{Creator: bob, Quote: postId})
+	require.NoError(err)
+	_, err = f.msgServer.Repost(f.ctx, &types.MsgRepostRequest{Creator: bob, Quote: postId})
+	require.ErrorIs(err, types.ErrAlreadyReposted)
+
+	// the reposted post is attributed to the reposter
+	res, err := f.queryServer.QueryUserCreatedPosts(f.ctx, &types.QueryUserCreatedPostsRequest{Address: bob, Page: 1})
+	require.NoError(err)
+	require.Len(res.Posts, 1)
+	require.NotNil(res.Posts[0].Repost)
+	require.Equal(bob, res.Posts[0].Repost.Reposter)
+	post, _ := f.k.GetPost(f.ctx, postId)
+	require.Equal(uint64(1), post.RepostCount)
+
+	_, err = f.msgServer.UndoRepost(f.ctx, &types.MsgUndoRepostRequest{Creator: bob, PostId: postId})
+	require.NoError(err)
+	post, _ = f.k.GetPost(f.ctx, postId)
+	require.Equal(uint64(0), post.RepostCount)
+	count, _ := f.k.GetUserCreatedPostsCount(f.ctx, bob)
+	require.Equal(int64(0), count)
+	_, found := f.k.GetRepost(f.ctx, bob, postId)
+	require.False(found)
+
+	_, err = f.msgServer.UndoRepost(f.ctx, &types.MsgUndoRepostRequest{Creator: bob, PostId: postId})
+	require.ErrorIs(err, types.ErrRepostNotFound)
+}
+
+func TestScheduledPost(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice := f.addrs[0].String()
+	bob := f.addrs[1].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	schedule := func(content string, publishAt int64) (*types.MsgCreatePostResponse, error) {
+		return f.msgServer.CreatePost(ctx, &types.MsgCreatePost{
+			Creator:    alice,
+			PostDetail: &types.PostDetail{Content: content},
+			PublishAt:  publishAt,
+		})
+	}
+	_, err := schedule("too early", 900)
+	require.Error(err)
+	_, err = schedule("too late", 1000+types.MaxScheduleAhead+1)
+	require.Error(err)
+
+	res, err := schedule("announcement", 2000)
+	require.NoError(err)
+	require.Empty(res.PostId)
+	require.NotEmpty(res.ScheduledId)
+	cancelled, err := schedule("cancelled", 3000)
+	require.NoError(err)
+
+	pending, err := f.queryServer.QueryScheduledPosts(ctx, &types.QueryScheduledPostsRequest{Creator: alice, Page: 1})
+	require.NoError(err)
+	require.Len(pending.ScheduledPosts, 2)
+	require.Equal(res.ScheduledId, pending.ScheduledPosts[0].Id)
+
+	// only the creator can cancel
+	_, err = f.msgServer.CancelScheduledPost(ctx, &types.MsgCancelScheduledPostRequest{Creator: bob, ScheduledId: cancelled.ScheduledId})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.CancelScheduledPost(ctx, &types.MsgCancelScheduledPostRequest{Creator: alice, ScheduledId: cancelled.ScheduledId})
+	require.NoError(err)
+
+	// nothing is due before the publish time
+	require.NoError(f.k.EndBlocker(ctx.WithBlockTime(time.Unix(1999, 0))))
+	count, _ := f.k.GetUserCreatedPostsCount(ctx, alice)
+	require.Equal(int64(0), count)
+
+	require.NoError(f.k.EndBlocker(ctx.WithBlockTime(time.Unix(2000, 0))))
+	count, _ = f.k.GetUserCreatedPostsCount(ctx, alice)
+	require.Equal(int64(1), count)
+	_, found := f.k.GetScheduledPost(ctx, res.ScheduledId)
+	require.False(found)
+	pending, err = f.queryServer.QueryScheduledPosts(ctx, &types.QueryScheduledPostsRequest{Creator: alice, Page: 1})
+	require.NoError(err)
+	require.Empty(pending.ScheduledPosts)
+}
+
+func TestPollVoting(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{
+		Creator: alice,
+		PostDetail: &types.PostDetail{
+			Content: "favourite colours?",
+			Poll: &types.Poll{
+				VotingStart:     1000,
+				VotingEnd:       2000,
+				Vote:            []*types.Vote{{Id: 1, Option: "red"}, {Id: 2, Option: "green"}, {Id: 3, Option: "blue"}},
+				MaxSelections:   2,
+				AllowVoteChange: true,
+				HideResults:     true,
+			},
+		},
+	})
+	require.NoError(err)
+	vote := func(voter string, optionIds ...int64) error {
+		_, err := f.msgServer.CastVoteOnPoll(ctx, &types.CastVoteOnPollRequest{Creator: voter, Id: res.PostId, OptionIds: optionIds})
+		return err
+	}
+
+	require.Error(vote(bob, 1, 2, 3))
+	require.Error(vote(bob, 1, 1))
+	require.Error(vote(bob, 4))
+	require.NoError(vote(bob, 1, 2))
+	require.NoError(vote(carol, 1))
+	require.NoError(vote(bob, 3))
+
+	option, err := f.queryServer.QueryVoteOption(ctx, &types.QueryVoteOptionRequest{Address: bob, PostId: res.PostId})
+	require.NoError(err)
+	require.Equal([]int64{3}, option.OptionIds)
+
+	// results stay hidden while voting is open
+	post, _ := f.k.GetPost(ctx, res.PostId)
+	require.Equal(int64(0), post.Poll.TotalVotes)
+	require.Equal(int64(0), post.Poll.Vote[0].Count)
+
+	require.NoError(f.k.EndBlocker(ctx.WithBlockTime(time.Unix(2000, 0))))
+	post, _ = f.k.GetPost(ctx, res.PostId)
+	require.False(post.Poll.Finalized)
+
+	ctx = ctx.WithBlockTime(time.Unix(2001, 0))
+	require.ErrorIs(vote(carol, 2), types.ErrVotingEnded)
+	require.NoError(f.k.EndBlocker(ctx))
+	post, _ = f.k.GetPost(ctx, res.PostId)
+	require.True(post.Poll.Finalized)
+	require.Equal(int64(2), post.Poll.TotalVotes)
+	require.Equal([]int64{1, 0, 1}, []int64{post.Poll.Vote[0].Count, post.Poll.Vote[1].Count, post.Poll.Vote[2].Count})
+
+	for _, addr := range []string{alice, bob, carol} {
+		count, _ := f.k.ProfileKeeper.GetActivitiesReceivedCount(ctx, addr)
+		require.Equal(int64(1), count, addr)
+	}
+	queued, _ := f.k.GetPollNotifyQueue(ctx, 10)
+	require.Empty(queued)
+
+	// the voters are paged through in address order
+	voters := f.k.GetPollVoters(ctx, res.PostId, "", 1)
+	require.Len(voters, 1)
+	rest := f.k.GetPollVoters(ctx, res.PostId, voters[0], 10)
+	require.Len(rest, 1)
+	require.NotEqual(voters[0], rest[0])
+}
+
+func TestPollSingleChoice(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{
+		Creator: alice,
+		PostDetail: &types.PostDetail{
+			Content: "yes or no?",
+			Poll: &types.Poll{
+				VotingStart: 1000,
+				VotingEnd:   2000,
+				Vote:        []*types.Vote{{Id: 1, Option: "yes"}, {Id: 2, Option: "no"}},
+			},
+		},
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.CastVoteOnPoll(ctx, &types.CastVoteOnPollRequest{Creator: bob, Id: res.PostId, OptionIds: []int64{1, 2}})
+	require.Error(err)
+	_, err = f.msgServer.CastVoteOnPoll(ctx, &types.CastVoteOnPollRequest{Creator: bob, Id: res.PostId, OptionId: 2})
+	require.NoError(err)
+	_, err = f.msgServer.CastVoteOnPoll(ctx, &types.CastVoteOnPollRequest{Creator: bob, Id: res.PostId, OptionId: 1})
+	require.ErrorIs(err, types.ErrAlreadyVoted)
+
+	post, _ := f.k.GetPost(ctx, res.PostId)
+	require.Equal(int64(1), post.Poll.TotalVotes)
+	require.Equal(int64(1), post.Poll.Vote[1].Count)
+}
+
+func TestContentLabels(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	create := func(content string, labels ...string) (*types.MsgCreatePostResponse, error) {
+		return f.msgServer.CreatePost(ctx, &types.MsgCreatePost{
+			Creator:    alice,
+			PostDetail: &types.PostDetail{Content: content, Labels: labels},
+		})
+	}
+	_, err := create("unknown label", "gore")
+	require.Error(err)
+	labeled, err := create("labeled", types.LabelNSFW)
+	require.NoError(err)
+	plain, err := create("plain")
+	require.NoError(err)
+
+	home := func(req *types.QueryHomePostsRequest) []string {
+		res, err := f.queryServer.QueryHomePosts(ctx, req)
+		require.NoError(err)
+		var ids []string
+		for _, post := range res.Posts {
+			ids = append(ids, post.Post.Id)
+		}
+		return ids
+	}
+	require.Len(home(&types.QueryHomePostsRequest{}), 2)
+	require.Equal([]string{plain.PostId}, home(&types.QueryHomePostsRequest{ExcludeLabels: []string{types.LabelNSFW}}))
+
+	// the viewer's default filter applies when the request has none
+	profile, _ := f.k.ProfileKeeper.GetProfile(ctx, bob)
+	profile.ExcludedLabels = []string{types.LabelSpoiler}
+	f.k.ProfileKeeper.SetProfile(ctx, profile)
+	require.Len(home(&types.QueryHomePostsRequest{Viewer: bob}), 2)
+
+	// only admins can add labels
+	addSpoiler := &types.MsgAddPostLabelsRequest{Creator: bob, PostId: plain.PostId, Labels: []string{types.LabelSpoiler}}
+	_, err = f.msgServer.AddPostLabels(ctx, addSpoiler)
+	require.ErrorIs(err, types.ErrRequestDenied)
+	profile.AdminLevel = 1
+	f.k.ProfileKeeper.SetProfile(ctx, profile)
+	_, err = f.msgServer.AddPostLabels(ctx, addSpoiler)
+	require.NoError(err)
+
+	require.Equal([]string{labeled.PostId}, home(&types.QueryHomePostsRequest{Viewer: bob}))
+}
+
+func TestReportPost(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "hello"}})
+	require.NoError(err)
+	report := func(reporter string) error {
+		_, err := f.msgServer.ReportPost(ctx, &types.MsgReportPostRequest{Creator: reporter, PostId: res.PostId, Reason: types.ReportReason_REPORT_REASON_SPAM})
+		return err
+	}
+	_, err = f.msgServer.ReportPost(ctx, &types.MsgReportPostRequest{Creator: bob, PostId: res.PostId})
+	require.Error(err)
+	require.Error(report(alice))
+	require.NoError(report(bob))
+	require.ErrorIs(report(bob), types.ErrAlreadyReported)
+	require.NoError(report(carol))
+
+	// anyone can list the queue, the reporters are left out
+	require.NoError(f.k.ProfileKeeper.AddAdmin(ctx, carol))
+	queue, err := f.queryServer.QueryReports(ctx, &types.QueryReportsRequest{Page: 1})
+	require.NoError(err)
+	require.Len(queue.ReportedPosts, 1)
+	require.Equal(uint64(2), queue.ReportedPosts[0].ReportCount)
+	require.Len(queue.ReportedPosts[0].Reports, 2)
+	require.Empty(queue.ReportedPosts[0].Reports[0].Reporter)
+
+	post, err := f.queryServer.QueryPost(ctx, &types.QueryPostRequest{PostId: res.PostId, Viewer: carol})
+	require.NoError(err)
+	require.Equal(uint64(2), post.Post.ReportCount)
+	post, err = f.queryServer.QueryPost(ctx, &types.QueryPostRequest{PostId: res.PostId, Viewer: bob})
+	require.NoError(err)
+	require.Zero(post.Post.ReportCount)
+
+	resolve := &types.MsgResolveReportRequest{Creator: carol, PostId: res.PostId, Outcome: types.ReportOutcome_REPORT_OUTCOME_LABEL, Labels: []string{types.LabelSensitive}}
+	_, err = f.msgServer.ResolveReport(ctx, &types.MsgResolveReportRequest{Creator: bob, PostId: res.PostId, Outcome: types.ReportOutcome_REPORT_OUTCOME_DISMISS})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.ResolveReport(ctx, resolve)
+	require.NoError(err)
+	labeled, _ := f.k.GetPost(ctx, res.PostId)
+	require.Equal([]string{types.LabelSensitive}, labeled.Labels)
+	queue, err = f.queryServer.QueryReports(ctx, &types.QueryReportsRequest{Page: 1})
+	require.NoError(err)
+	require.Empty(queue.ReportedPosts)
+	_, err = f.msgServer.ResolveReport(ctx, resolve)
+	require.Error(err)
+
+	// a resolved report can be filed again
+	require.NoError(report(bob))
+	_, err = f.msgServer.ResolveReport(ctx, &types.MsgResolveReportRequest{Creator: carol, PostId: res.PostId, Outcome: types.ReportOutcome_REPORT_OUTCOME_TAKE_DOWN})
+	require.NoError(err)
+	takenDown, found := f.k.GetPost(ctx, res.PostId)
+	require.True(found)
+	require.Equal(types.TakedownStatus_TAKEDOWN_STATUS_TAKEN_DOWN, takenDown.TakedownStatus)
+	require.Zero(f.k.GetReportCount(ctx, res.PostId))
+}
+
+func TestTakedownAndAppeal(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	for addr, level := range map[string]int64{bob: 1, carol: 2} {
+		profile, _ := f.k.ProfileKeeper.GetProfile(ctx, addr)
+		profile.AdminLevel = level
+		f.k.ProfileKeeper.SetProfile(ctx, profile)
+	}
+
+	res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "hello"}})
+	require.NoError(err)
+	post, _ := f.k.GetPost(ctx, res.PostId)
+
+	_, err = f.msgServer.TakedownPost(ctx, &types.MsgTakedownPostRequest{Creator: alice, PostId: res.PostId, Reason: "spam"})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.TakedownPost(ctx, &types.MsgTakedownPostRequest{Creator: bob, PostId: res.PostId})
+	require.Error(err)
+	_, err = f.msgServer.TakedownPost(ctx, &types.MsgTakedownPostRequest{Creator: bob, PostId: res.PostId, Reason: "spam"})
+	require.NoError(err)
+	require.False(f.k.IsPostInHomePosts(ctx, res.PostId, post.HomePostsUpdate))
+	count, _ := f.k.GetUserCreatedPostsCount(ctx, alice)
+	require.Zero(count)
+
+	// a taken down post cannot be interacted with
+	_, err = f.msgServer.Like(ctx, &types.MsgLikeRequest{Sender: carol, Id: res.PostId})
+	require.ErrorIs(err, types.ErrRequestDenied)
+
+	appeal := &types.MsgAppealTakedownRequest{Creator: alice, PostId: res.PostId, Appeal: "not spam"}
+	_, err = f.msgServer.AppealTakedown(ctx, &types.MsgAppealTakedownRequest{Creator: bob, PostId: res.PostId, Appeal: "not spam"})
+	require.Error(err)
+	_, err = f.msgServer.AppealTakedown(ctx, appeal)
+	require.NoError(err)
+	_, err = f.msgServer.AppealTakedown(ctx, appeal)
+	require.Error(err)
+
+	// the appeal is decided by a higher level admin than the moderator
+	_, err = f.msgServer.DecideAppeal(ctx, &types.MsgDecideAppealRequest{Creator: bob, PostId: res.PostId, Restore: true})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.DecideAppeal(ctx, &types.MsgDecideAppealRequest{Creator: carol, PostId: res.PostId, Restore: true, Note: "restored"})
+	require.NoError(err)
+
+	restored, _ := f.k.GetPost(ctx, res.PostId)
+	require.Equal(types.TakedownStatus_TAKEDOWN_STATUS_NONE, restored.TakedownStatus)
+	require.True(restored.Takedown.Restored)
+	require.Equal(carol, restored.Takedown.DecidedBy)
+	require.True(f.k.IsPostInHomePosts(ctx, res.PostId, post.HomePostsUpdate))
+	count, _ = f.k.GetUserCreatedPostsCount(ctx, alice)
+	require.Equal(int64(1), count)
+
+	// a restored post of a private account stays out of the public feeds
+	profile, _ := f.k.ProfileKeeper.GetProfile(ctx, alice)
+	profile.Private = true
+	f.k.ProfileKeeper.SetProfile(ctx, profile)
+	res, err = f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "friends only"}})
+	require.NoError(err)
+	_, err = f.msgServer.TakedownPost(ctx, &types.MsgTakedownPostRequest{Creator: bob, PostId: res.PostId, Reason: "spam"})
+	require.NoError(err)
+	_, err = f.msgServer.AppealTakedown(ctx, &types.MsgAppealTakedownRequest{Creator: alice, PostId: res.PostId, Appeal: "not spam"})
+	require.NoError(err)
+	_, err = f.msgServer.DecideAppeal(ctx, &types.MsgDecideAppealRequest{Creator: carol, PostId: res.PostId, Restore: true})
+	require.NoError(err)
+	require.False(f.k.IsPostInHomePosts(ctx, res.PostId, 1000))
+	count, _ = f.k.GetUserCreatedPostsCount(ctx, alice)
+	require.Equal(int64(2), count)
+}
+
+func TestBlockedSender(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "hello"}})
+	require.NoError(err)
+	_, err = f.msgServer.Comment(ctx, &types.MsgCommentRequest{Creator: carol, ParentId: res.PostId, Comment: "first"})
+	require.NoError(err)
+	ids, _, _, err := f.k.GetCommentsByParentId(ctx, res.PostId, 1)
+	require.NoError(err)
+	require.Len(ids, 1)
+	f.k.ProfileKeeper.BlockUser(ctx, alice, bob)
+
+	_, err = f.msgServer.Comment(ctx, &types.MsgCommentRequest{Creator: bob, ParentId: res.PostId, Comment: "hi"})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	// replies anywhere under the blocker's post are rejected too
+	_, err = f.msgServer.Comment(ctx, &types.MsgCommentRequest{Creator: bob, ParentId: ids[0], Comment: "hi"})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.QuotePost(ctx, &types.MsgQuotePostRequest{Creator: bob, Quote: res.PostId, Comment: "hi"})
+	require.ErrorIs(err, types.ErrRequestDenied)
+
+	// mentions of the blocker are skipped
+	aliceProfile, _ := f.k.ProfileKeeper.GetProfile(ctx, alice)
+	before, _ := f.k.ProfileKeeper.GetActivitiesReceivedCount(ctx, alice)
+	created, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: bob, PostDetail: &types.PostDetail{Content: "hey", Mention: []string{aliceProfile.UserHandle}}})
+	require.NoError(err)
+	after, _ := f.k.ProfileKeeper.GetActivitiesReceivedCount(ctx, alice)
+	require.Equal(before, after)
+
+	// an edit does not get around the block and only notifies new mentions
+	f.k.ProfileKeeper.CheckAndCreateUserHandle(ctx, carol)
+	carolProfile, _ := f.k.ProfileKeeper.GetProfile(ctx, carol)
+	carolBefore, _ := f.k.ProfileKeeper.GetActivitiesReceivedCount(ctx, carol)
+	for _, content := range []string{"hey you", "hey you two"} {
+		_, err = f.msgServer.EditPost(ctx, &types.MsgEditPostRequest{
+			Creator: bob,
+			PostId:  created.PostId,
+			Content: content,
+			Mention: []string{aliceProfile.UserHandle, carolProfile.UserHandle},
+		})
+		require.NoError(err)
+	}
+	after, _ = f.k.ProfileKeeper.GetActivitiesReceivedCount(ctx, alice)
+	require.Equal(before, after)
+	carolAfter, _ := f.k.ProfileKeeper.GetActivitiesReceivedCount(ctx, carol)
+	require.Equal(carolBefore+1, carolAfter)
+}
+
+func TestMutedFeeds(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	create := func(creator string, detail *types.PostDetail) string {
+		res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, PostDetail: detail})
+		require.NoError(err)
+		return res.PostId
+	}
+	fromBob := create(bob, &types.PostDetail{Content: "hello"})
+	spoiler := create(carol, &types.PostDetail{Content: "Big SPOILERS ahead"})
+	topical := create(carol, &types.PostDetail{Content: "about go", Topic: []string{"golang"}})
+	own := create(alice, &types.PostDetail{Content: "my spoilers"})
+
+	home := func() []string {
+		res, err := f.queryServer.QueryHomePosts(ctx, &types.QueryHomePostsRequest{Viewer: alice})
+		require.NoError(err)
+		var ids []string
+		for _, post := range res.Posts {
+			ids = append(ids, post.Post.Id)
+		}
+		return ids
+	}
+	require.Len(home(), 4)
+
+	topics := f.k.GetTopicsByPostId(ctx, topical)
+	require.Len(topics, 1)
+	f.k.ProfileKeeper.SetMute(ctx, alice, profiletypes.MuteType_MUTE_TYPE_ACCOUNT, bob)
+	f.k.ProfileKeeper.SetMute(ctx, alice, profiletypes.MuteType_MUTE_TYPE_KEYWORD, "spoilers")
+	f.k.ProfileKeeper.SetMute(ctx, alice, profiletypes.MuteType_MUTE_TYPE_TOPIC, topics[0])
+
+	// the viewer's own posts are kept
+	ids := home()
+	require.Equal([]string{own}, ids)
+	require.NotContains(ids, fromBob)
+	require.NotContains(ids, spoiler)
+
+	// muted accounts are dropped from the notifications
+	_, err := f.msgServer.Like(ctx, &types.MsgLikeRequest{Sender: bob, Id: own})
+	require.NoError(err)
+	_, err = f.msgServer.Like(ctx, &types.MsgLikeRequest{Sender: carol, Id: own})
+	require.NoError(err)
+	activities, err := f.queryServer.QueryActivitiesReceived(ctx, &types.QueryActivitiesReceivedRequest{Address: alice, Page: 1})
+	require.NoError(err)
+	require.Len(activities.ActivitiesReceived, 1)
+	require.Equal(carol, activities.ActivitiesReceived[0].Address)
+}
+
+func TestTopicPermissions(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "about go", Topic: []string{"golang"}}})
+	require.NoError(err)
+	topics := f.k.GetTopicsByPostId(ctx, res.PostId)
+	require.Len(topics, 1)
+
+	update := &types.AdminUpdateTopicCategoryRequest{Creator: bob, TopicIds: topics}
+	_, err = f.msgServer.AdminUpdateTopicCategory(ctx, update)
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.AddCategory(ctx, &types.AddCategoryRequest{Creator: bob, Params: &types.CategoryParams{Name: "tech"}})
+	require.ErrorIs(err, types.ErrRequestDenied)
+
+	// an editor can edit topics but not manage categories
+	profile, _ := f.k.ProfileKeeper.GetProfile(ctx, bob)
+	profile.AdminLevel = 2
+	f.k.ProfileKeeper.SetProfile(ctx, profile)
+	_, err = f.msgServer.AdminUpdateTopicCategory(ctx, update)
+	require.NoError(err)
+	_, err = f.msgServer.AddCategory(ctx, &types.AddCategoryRequest{Creator: bob, Params: &types.CategoryParams{Name: "tech"}})
+	require.ErrorIs(err, types.ErrRequestDenied)
+}
+
+func TestTopicAdmin(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	first, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "first", Topic: []string{"golang"}}})
+	require.NoError(err)
+	topicId := f.k.GetTopicsByPostId(ctx, first.PostId)[0]
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0)).WithTxBytes([]byte("tx2"))
+	second, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: bob, PostDetail: &types.PostDetail{Content: "second", Topic: []string{"golang"}}})
+	require.NoError(err)
+
+	// only the creator of the topic can claim it
+	_, err = f.msgServer.ClaimTopic(ctx, &types.MsgClaimTopicRequest{Creator: bob, TopicId: topicId})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.ClaimTopic(ctx, &types.MsgClaimTopicRequest{Creator: alice, TopicId: topicId})
+	require.NoError(err)
+	topic, _ := f.k.GetTopic(ctx, topicId)
+	require.Equal(alice, topic.TopicAdmin)
+
+	// bob can not moderate the topic
+	_, err = f.msgServer.PinTopicPost(ctx, &types.MsgPinTopicPostRequest{Creator: bob, TopicId: topicId, PostId: first.PostId})
+	require.ErrorIs(err, types.ErrRequestDenied)
+
+	// the pinned post leads the topic feed
+	_, err = f.msgServer.PinTopicPost(ctx, &types.MsgPinTopicPostRequest{Creator: alice, TopicId: topicId, PostId: first.PostId})
+	require.NoError(err)
+	res, err := f.queryServer.QueryTopicPosts(ctx, &types.QueryTopicPostsRequest{TopicId: topicId})
+	require.NoError(err)
+	require.Len(res.Posts, 2)
+	require.Equal(first.PostId, res.Posts[0].Post.Id)
+	require.True(res.Posts[0].Pinned)
+	require.False(res.Posts[1].Pinned)
+
+	// a removed post leaves the topic and its pins
+	_, err = f.msgServer.RemoveTopicPost(ctx, &types.MsgRemoveTopicPostRequest{Creator: alice, TopicId: topicId, PostId: first.PostId})
+	require.NoError(err)
+	require.Empty(f.k.GetTopicPins(ctx, topicId))
+	require.Empty(f.k.GetTopicsByPostId(ctx, first.PostId))
+	res, err = f.queryServer.QueryTopicPosts(ctx, &types.QueryTopicPostsRequest{TopicId: topicId})
+	require.NoError(err)
+	require.Len(res.Posts, 1)
+	require.Equal(second.PostId, res.Posts[0].Post.Id)
+
+	// the topic admin edits how the topic is presented, not its score
+	_, err = f.msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{Creator: alice, TopicJson: &types.UpdateTopicJson{Id: topicId, Title: "Go"}})
+	require.NoError(err)
+	_, err = f.msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{Creator: alice, TopicJson: &types.UpdateTopicJson{Id: topicId, Score: 100}})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	topic, _ = f.k.GetTopic(ctx, topicId)
+	require.Equal("Go", topic.Title)
+
+	// handing the topic over to bob
+	_, err = f.msgServer.SetTopicAdmin(ctx, &types.MsgSetTopicAdminRequest{Creator: alice, TopicId: topicId, Admin: bob})
+	require.NoError(err)
+	_, err = f.msgServer.UpdateTopic(ctx, &types.UpdateTopicRequest{Creator: alice, TopicJson: &types.UpdateTopicJson{Id: topicId, Title: "Golang"}})
+	require.ErrorIs(err, types.ErrRequestDenied)
+}
+
+func TestPinPost(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+
+	var postIds []string
+	for i := int64(0); i < 4; i++ {
+		ctx := f.ctx.WithBlockTime(time.Unix(1000+i, 0)).WithTxBytes([]byte(fmt.Sprintf("tx%d", i)))
+		res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: fmt.Sprintf("post %d", i)}})
+		require.NoError(err)
+		postIds = append(postIds, res.PostId)
+	}
+	ctx := f.ctx.WithBlockTime(time.Unix(2000, 0))
+
+	// only the creator can pin a post
+	_, err := f.msgServer.PinPost(ctx, &types.MsgPinPostRequest{Creator: bob, PostId: postIds[0]})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	for _, postId := range postIds[:types.DefaultMaxPinnedPosts] {
+		_, err = f.msgServer.PinPost(ctx, &types.MsgPinPostRequest{Creator: alice, PostId: postId})
+		require.NoError(err)
+	}
+	_, err = f.msgServer.PinPost(ctx, &types.MsgPinPostRequest{Creator: alice, PostId: postIds[3]})
+	require.ErrorIs(err, types.ErrInvalidRequest)
+
+	// pinned posts lead the profile, followed by the rest in reverse order
+	_, err = f.msgServer.UnpinPost(ctx, &types.MsgUnpinPostRequest{Creator: alice, PostId: postIds[1]})
+	require.NoError(err)
+	res, err := f.queryServer.QueryUserCreatedPosts(ctx, &types.QueryUserCreatedPostsRequest{Address: alice, Page: 1})
+	require.NoError(err)
+	require.Len(res.Posts, 4)
+	require.True(res.Posts[0].Pinned)
+	require.True(res.Posts[1].Pinned)
+	require.ElementsMatch([]string{postIds[0], postIds[2]}, []string{res.Posts[0].Post.Id, res.Posts[1].Post.Id})
+	require.Equal(postIds[3], res.Posts[2].Post.Id)
+	require.Equal(postIds[1], res.Posts[3].Post.Id)
+	require.False(res.Posts[2].Pinned)
+
+	// a deleted post gives its pin back
+	_, err = f.msgServer.DeletePost(ctx, &types.MsgDeletePostRequest{Creator: alice, PostId: postIds[0]})
+	require.NoError(err)
+	require.Equal([]string{postIds[2]}, f.k.GetUserPins(ctx, alice))
+	_, err = f.msgServer.PinPost(ctx, &types.MsgPinPostRequest{Creator: alice, PostId: postIds[3]})
+	require.NoError(err)
+}
+
+func TestDuplicateContent(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+
+	params := types.DefaultParams()
+	params.DuplicateWindow = 3600
+	params.DuplicateFlagThreshold = 3
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	create := func(creator string, content string, at int64) (string, error) {
+		ctx := f.ctx.WithBlockTime(time.Unix(at, 0)).WithTxBytes([]byte(fmt.Sprintf("tx%d", at)))
+		res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, PostDetail: &types.PostDetail{Content: content}})
+		if err != nil {
+			return "", err
+		}
+		return res.PostId, nil
+	}
+
+	first, err := create(alice, "Buy now!", 1000)
+	require.NoError(err)
+	// case and whitespace do not make the content new
+	_, err = create(alice, "  buy   NOW! ", 1010)
+	require.ErrorIs(err, types.ErrDuplicateContent)
+
+	// the posts are flagged once the content is repeated often enough
+	second, err := create(bob, "buy now!", 1020)
+	require.NoError(err)
+	require.Zero(f.k.GetReportCount(f.ctx, first))
+	third, err := create(carol, "BUY NOW!", 1030)
+	require.NoError(err)
+	for _, postId := range []string{first, second, third} {
+		require.Equal(uint64(1), f.k.GetReportCount(f.ctx, postId))
+	}
+	queue, _, _, err := f.k.GetReportQueue(f.ctx, 1)
+	require.NoError(err)
+	require.Len(queue, 3)
+
+	// the content can be posted again once the window has passed
+	_, err = create(alice, "buy now!", 1000+3600)
+	require.NoError(err)
+	require.NoError(f.k.EndBlocker(f.ctx.WithBlockTime(time.Unix(1020+3600, 0))))
+	contentHash := sha256.Sum256([]byte(types.NormalizeContent("", "buy now!")))
+	_, found := f.k.GetCreatorContentTime(f.ctx, bob, hex.EncodeToString(contentHash[:]))
+	require.False(found)
+	_, found = f.k.GetCreatorContentTime(f.ctx, alice, hex.EncodeToString(contentHash[:]))
+	require.True(found)
+}
+
+func TestPrivateAccountPosts(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	profile, _ := f.k.ProfileKeeper.GetProfile(ctx, bob)
+	profile.Private = true
+	f.k.ProfileKeeper.SetProfile(ctx, profile)
+	res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: bob, PostDetail: &types.PostDetail{Content: "friends only"}})
+	require.NoError(err)
+	_, err = f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "hello"}})
+	require.NoError(err)
+
+	// private posts are left out of the public feeds
+	home, err := f.queryServer.QueryHomePosts(ctx, &types.QueryHomePostsRequest{Viewer: bob})
+	require.NoError(err)
+	require.Len(home.Posts, 1)
+	require.Equal(alice, home.Posts[0].Post.Creator)
+
+	userPosts := func(viewer string) int {
+		res, err := f.queryServer.QueryUserCreatedPosts(ctx, &types.QueryUserCreatedPostsRequest{Address: bob, Viewer: viewer})
+		require.NoError(err)
+		return len(res.Posts)
+	}
+	require.Equal(1, userPosts(bob))
+	require.Equal(0, userPosts(alice))
+	require.Equal(0, userPosts(""))
+
+	// approved followers see them
+	f.k.ProfileKeeper.AddToFollowing(ctx, alice, bob)
+	f.k.ProfileKeeper.SetFollowTime(ctx, alice, bob)
+	require.Equal(1, userPosts(alice))
+	following, err := f.queryServer.QueryFollowingPosts(ctx, &types.QueryFollowingPostsRequest{Address: alice})
+	require.NoError(err)
+	require.Len(following.Posts, 1)
+	require.Equal(res.PostId, following.Posts[0].Post.Id)
+
+	// the feeds follow the account in and out of privacy
+	homeLen := func() int {
+		home, err := f.queryServer.QueryHomePosts(ctx, &types.QueryHomePostsRequest{Viewer: alice})
+		require.NoError(err)
+		return len(home.Posts)
+	}
+	profile.Private = false
+	f.k.ProfileKeeper.SetProfile(ctx, profile)
+	f.k.Hooks().AfterPrivacyChanged(ctx, bob, false)
+	require.Equal(2, homeLen())
+	require.True(f.k.IsPostInHomePosts(ctx, res.PostId, 1000))
+
+	profile.Private = true
+	f.k.ProfileKeeper.SetProfile(ctx, profile)
+	f.k.Hooks().AfterPrivacyChanged(ctx, bob, true)
+	require.Equal(1, homeLen())
+	require.False(f.k.IsPostInHomePosts(ctx, res.PostId, 1000))
+
+	// only followers quote a private post, and only they see the quote
+	carol := f.addrs[2].String()
+	_, err = f.msgServer.QuotePost(ctx, &types.MsgQuotePostRequest{Creator: carol, Quote: res.PostId, Comment: "leak"})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.QuotePost(ctx, &types.MsgQuotePostRequest{Creator: alice, Quote: res.PostId, Comment: "quoted"})
+	require.NoError(err)
+	quoted := func(viewer string) bool {
+		res, err := f.queryServer.QueryUserCreatedPosts(ctx, &types.QueryUserCreatedPostsRequest{Address: alice, Viewer: viewer})
+		require.NoError(err)
+		for _, post := range res.Posts {
+			if post.QuotePost != nil {
+				return true
+			}
+		}
+		return false
+	}
+	require.True(quoted(alice))
+	require.False(quoted(carol))
+}
+
+func TestFollowSuggestions(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol, dave := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String(), f.addrs[3].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	follow := func(follower, target string) {
+		f.k.ProfileKeeper.AddToFollowing(ctx, follower, target)
+		f.k.ProfileKeeper.AddToFollowers(ctx, target, follower)
+		f.k.ProfileKeeper.SetFollowTime(ctx, follower, target)
+	}
+	follow(alice, bob)
+	follow(bob, carol)
+	follow(bob, dave)
+	follow(bob, alice)
+	profile, _ := f.k.ProfileKeeper.GetProfile(ctx, carol)
+	profile.Score = 1
+	f.k.ProfileKeeper.SetProfile(ctx, profile)
+	// dave shares a followed topic with alice
+	for _, address := range []string{alice, dave} {
+		f.k.FollowTopic(ctx, address, "topic")
+		f.k.SetFollowTopicTime(ctx, address, "topic")
+	}
+
+	suggested := func() []string {
+		res, err := f.queryServer.QueryFollowSuggestions(ctx, &types.QueryFollowSuggestionsRequest{Viewer: alice})
+		require.NoError(err)
+		var addresses []string
+		for _, suggestion := range res.Suggestions {
+			addresses = append(addresses, suggestion.Profile.WalletAddress)
+		}
+		return addresses
+	}
+	res, err := f.queryServer.QueryFollowSuggestions(ctx, &types.QueryFollowSuggestionsRequest{Viewer: alice})
+	require.NoError(err)
+	require.Len(res.Suggestions, 2)
+	require.Equal(dave, res.Suggestions[0].Profile.WalletAddress)
+	require.Equal(uint64(1), res.Suggestions[0].MutualCount)
+	require.Equal(uint64(1), res.Suggestions[0].SharedTopics)
+	require.Equal(uint64(types.SuggestionMutualWeight+types.SuggestionTopicWeight), res.Suggestions[0].Rank)
+	require.Equal(carol, res.Suggestions[1].Profile.WalletAddress)
+
+	// blocked and followed accounts are skipped
+	f.k.ProfileKeeper.BlockUser(ctx, carol, alice)
+	require.Equal([]string{dave}, suggested())
+	follow(alice, dave)
+	require.Empty(suggested())
+
+	_, err = f.queryServer.QueryFollowSuggestions(ctx, &types.QueryFollowSuggestionsRequest{})
+	require.Error(err)
+}
+
+func TestListPosts(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+
+	list := profiletypes.UserList{Id: 1, Owner: alice, Name: "friends", MemberCount: 2}
+	f.k.ProfileKeeper.SetUserList(f.ctx, list)
+	f.k.ProfileKeeper.SetListMember(f.ctx, list.Id, bob)
+	f.k.ProfileKeeper.SetListMember(f.ctx, list.Id, carol)
+
+	var expected []string
+	for i := int64(0); i < 12; i++ {
+		creator := bob
+		if i%2 == 1 {
+			creator = carol
+		}
+		ctx := f.ctx.WithBlockTime(time.Unix(1000+i, 0)).WithTxBytes([]byte(fmt.Sprintf("tx%d", i)))
+		res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, PostDetail: &types.PostDetail{Content: fmt.Sprintf("post %d", i)}})
+		require.NoError(err)
+		expected = append([]string{res.PostId}, expected...)
+	}
+	_, err := f.msgServer.CreatePost(f.ctx.WithBlockTime(time.Unix(2000, 0)).WithTxBytes([]byte("alice")), &types.MsgCreatePost{Creator: alice, PostDetail: &types.PostDetail{Content: "not listed"}})
+	require.NoError(err)
+
+	listPosts := func(page uint64) []string {
+		res, err := f.queryServer.QueryListPosts(f.ctx, &types.QueryListPostsRequest{ListId: list.Id, Page: page, Viewer: bob})
+		require.NoError(err)
+		var ids []string
+		for _, post := range res.Posts {
+			ids = append(ids, post.Post.Id)
+		}
+		return ids
+	}
+	require.Equal(expected[:10], listPosts(1))
+	require.Equal(expected[10:], listPosts(2))
+	require.Empty(listPosts(3))
+
+	// private lists are only visible to their owner
+	list.Private = true
+	f.k.ProfileKeeper.SetUserList(f.ctx, list)
+	_, err = f.queryServer.QueryListPosts(f.ctx, &types.QueryListPostsRequest{ListId: list.Id, Viewer: bob})
+	require.Error(err)
+	res, err := f.queryServer.QueryListPosts(f.ctx, &types.QueryListPostsRequest{ListId: list.Id, Viewer: alice})
+	require.NoError(err)
+	require.Len(res.Posts, 10)
+}
+
+func TestPostAudience(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol, dave := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String(), f.addrs[3].String()
+	ctx := f.ctx.WithBlockTime(time.Unix(1000, 0)).WithTxBytes([]byte("tx"))
+
+	list := profiletypes.UserList{Id: 1, Owner: alice, Name: "close friends", MemberCount: 1}
+	f.k.ProfileKeeper.SetUserList(ctx, list)
+	f.k.ProfileKeeper.SetListMember(ctx, list.Id, bob)
+	f.k.ProfileKeeper.SetUserList(ctx, profiletypes.UserList{Id: 2, Owner: bob, Name: "bob's"})
+	f.k.ProfileKeeper.AddToFollowing(ctx, carol, alice)
+	f.k.ProfileKeeper.SetFollowTime(ctx, carol, alice)
+
+	create := func(content string, audience types.PostAudience, listId uint64) (string, error) {
+		res, err := f.msgServer.CreatePost(ctx, &types.MsgCreatePost{
+			Creator: alice,
+			PostDetail: &types.PostDetail{
+				Content:        content,
+				Topic:          []string{"news"},
+				Audience:       audience,
+				AudienceListId: listId,
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.PostId, nil
+	}
+	_, err := create("no list", types.PostAudience_POST_AUDIENCE_CLOSE_FRIENDS, 0)
+	require.Error(err)
+	_, err = create("someone else's list", types.PostAudience_POST_AUDIENCE_CLOSE_FRIENDS, 2)
+	require.ErrorIs(err, types.ErrRequestDenied)
+	public, err := create("public", types.PostAudience_POST_AUDIENCE_PUBLIC, 0)
+	require.NoError(err)
+	followers, err := create("followers", types.PostAudience_POST_AUDIENCE_FOLLOWERS, 0)
+	require.NoError(err)
+	closeFriends, err := create("close friends", types.PostAudience_POST_AUDIENCE_CLOSE_FRIENDS, list.Id)
+	require.NoError(err)
+
+	// restricted posts stay out of the home and topic indexes, even after a like
+	_, err = f.msgServer.Like(ctx, &types.MsgLikeRequest{Sender: bob, Id: closeFriends})
+	require.NoError(err)
+	home, err := f.queryServer.QueryHomePosts(ctx, &types.QueryHomePostsRequest{})
+	require.NoError(err)
+	require.Len(home.Posts, 1)
+	require.Equal(public, home.Posts[0].Post.Id)
+	for _, postId := range []string{followers, closeFriends} {
+		require.False(f.k.IsPostInHomePosts(ctx, postId, 1000))
+		topics := f.k.GetTopicsByPostId(ctx, postId)
+		require.Len(topics, 1)
+		require.False(f.k.IsPostInTopicPosts(ctx, topics[0], postId, 1000))
+	}
+
+	visible := func(viewer string) []string {
+		res, err := f.queryServer.QueryUserCreatedPosts(ctx, &types.QueryUserCreatedPostsRequest{Address: alice, Viewer: viewer})
+		require.NoError(err)
+		var ids []string
+		for _, post := range res.Posts {
+			ids = append(ids, post.Post.Id)
+		}
+		return ids
+	}
+	require.ElementsMatch([]string{public, followers, closeFriends}, visible(alice))
+	require.ElementsMatch([]string{public, closeFriends}, visible(bob))
+	require.ElementsMatch([]string{public, followers}, visible(carol))
+	require.ElementsMatch([]string{public}, visible(dave))
+
+	_, err = f.queryServer.QueryPost(ctx, &types.QueryPostRequest{PostId: closeFriends, Viewer: bob})
+	require.NoError(err)
+	_, err = f.queryServer.QueryPost(ctx, &types.QueryPostRequest{PostId: closeFriends, Viewer: carol})
+	require.Error(err)
+	_, err = f.queryServer.QueryPost(ctx, &types.QueryPostRequest{PostId: followers})
+	require.Error(err)
+
+	// restricted posts cannot be shared
+	_, err = f.msgServer.Repost(ctx, &types.MsgRepostRequest{Creator: bob, Quote: closeFriends})
+	require.ErrorIs(err, types.ErrRequestDenied)
+
+	// only the audience interacts with a restricted post and its comments
+	_, err = f.msgServer.Like(ctx, &types.MsgLikeRequest{Sender: dave, Id: closeFriends})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	require.False(f.k.HasUserLikedPost(ctx, dave, closeFriends))
+	_, err = f.msgServer.SavePost(ctx, &types.MsgSaveRequest{Sender: dave, Id: closeFriends})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.Comment(ctx, &types.MsgCommentRequest{Creator: dave, ParentId: closeFriends, Comment: "hi"})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.Comment(ctx, &types.MsgCommentRequest{Creator: bob, ParentId: closeFriends, Comment: "hi"})
+	require.NoError(err)
+	ids, _, _, err := f.k.GetCommentsByParentId(ctx, closeFriends, 1)
+	require.NoError(err)
+	require.Len(ids, 1)
+	_, err = f.msgServer.Comment(ctx, &types.MsgCommentRequest{Creator: dave, ParentId: ids[0], Comment: "hi"})
+	require.ErrorIs(err, types.ErrRequestDenied)
+
+	_, err = f.queryServer.QueryComments(ctx, &types.QueryCommentsRequest{Id: closeFriends, Viewer: dave})
+	require.Error(err)
+	comments, err := f.queryServer.QueryComments(ctx, &types.QueryCommentsRequest{Id: closeFriends, Viewer: bob})
+	require.NoError(err)
+	require.Len(comments.Comments, 1)
+	_, err = f.queryServer.QueryCommentThread(ctx, &types.QueryCommentThreadRequest{Id: ids[0], Viewer: dave})
+	require.Error(err)
+	_, err = f.queryServer.QueryCommentThread(ctx, &types.QueryCommentThreadRequest{Id: ids[0], Viewer: bob})
+	require.NoError(err)
+	_, err = f.queryServer.QueryPostRevisions(ctx, &types.QueryPostRevisionsRequest{PostId: closeFriends, Viewer: dave})
+	require.Error(err)
+
+	// another member's likes and notifications do not reveal the post
+	likes, err := f.queryServer.LikesIMade(ctx, &types.LikesIMadeRequest{Address: bob, Viewer: dave})
+	require.NoError(err)
+	require.Empty(likes.Posts)
+	likes, err = f.queryServer.LikesIMade(ctx, &types.LikesIMadeRequest{Address: bob, Viewer: bob})
+	require.NoError(err)
+	require.Len(likes.Posts, 1)
+	received, err := f.queryServer.QueryCommentsReceived(ctx, &types.QueryCommentsReceivedRequest{Address: alice, Viewer: dave})
+	require.NoError(err)
+	require.Empty(received.Comments)
+	activities, err := f.queryServer.QueryActivitiesReceived(ctx, &types.QueryActivitiesReceivedRequest{Address: alice, Page: 1, Viewer: dave})
+	require.NoError(err)
+	require.NotEmpty(activities.ActivitiesReceived)
+	for _, activity := range activities.ActivitiesReceived {
+		require.Nil(activity.ParentPost)
+	}
+
+	// mentions outside the audience are skipped
+	f.k.ProfileKeeper.CheckAndCreateUserHandle(ctx, dave)
+	daveProfile, _ := f.k.ProfileKeeper.GetProfile(ctx, dave)
+	before, _ := f.k.ProfileKeeper.GetActivitiesReceivedCount(ctx, dave)
+	_, err = f.msgServer.CreatePost(ctx, &types.MsgCreatePost{
+		Creator: alice,
+		PostDetail: &types.PostDetail{
+			Content:        "hey",
+			Mention:        []string{daveProfile.UserHandle},
+			Audience:       types.PostAudience_POST_AUDIENCE_CLOSE_FRIENDS,
+			AudienceListId: list.Id,
+		},
+	})
+	require.NoError(err)
+	_, err = f.msgServer.Comment(ctx, &types.MsgCommentRequest{Creator: bob, ParentId: closeFriends, Comment: "look", Mention: []string{daveProfile.UserHandle}})
+	require.NoError(err)
+	after, _ := f.k.ProfileKeeper.GetActivitiesReceivedCount(ctx, dave)
+	require.Equal(before, after)
+}
@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"encoding/base64"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -51,8 +54,9 @@ func (k Querier) ResolveName(goCtx context.Context, req *types.QueryResolveNameR
 	}, nil
 }
 
-// BatchGetPostsWithProfiles retrieves posts and associated profiles in batch to optimize performance
-func (k Querier) batchGetPostsWithProfiles(ctx sdk.Context, postIDs []string) ([]*types.PostResponse, error) {
+// BatchGetPostsWithProfiles retrieves posts and associated profiles in batch to optimize performance,
+// quoted posts the viewer may not see are left out
+func (k Querier) batchGetPostsWithProfiles(ctx sdk.Context, viewer string, postIDs []string) ([]*types.PostResponse, error) {
 	// Step 1: Batch retrieve all main posts
 	posts := make(map[string]types.Post)
 	quotePosts := make(map[string]types.Post)
@@ -135,7 +139,7 @@ func (k Querier) batchGetPostsWithProfiles(ctx sdk.Context, postIDs []string) ([
 				foundQuote = true
 			}
 
-			if foundQuote {
+			if foundQuote && k.canViewQuote(ctx, viewer, quotePost) {
 				postResponse.QuotePost = &quotePost
 				if quoteProfile, hasQuoteProfile := profiles[quotePost.Creator]; hasQuoteProfile {
 					postResponse.QuoteProfile = &quoteProfile
@@ -167,11 +171,13 @@ func (k Querier) QueryHomePosts(goCtx context.Context, req *types.QueryHomePosts
 	}
 
 	// Use batch query to optimize performance
-	postResponses, err := k.batchGetPostsWithProfiles(ctx, postIDs)
+	postResponses, err := k.batchGetPostsWithProfiles(ctx, req.Viewer, postIDs)
 	if err != nil {
 		types.LogError(k.logger, "batchGetPostsWithProfiles", err, "operation", "QueryHomePosts")
 		return nil, types.ToGRPCError(types.ErrDatabaseOperation)
 	}
+	postResponses = filterLabeledPosts(k.filterPrivatePosts(ctx, "", postResponses), k.excludedLabels(ctx, req.Viewer, req.ExcludeLabels))
+	postResponses = k.withReportCounts(ctx, req.Viewer, k.filterMutedPosts(ctx, req.Viewer, postResponses))
 
 	return &types.QueryHomePostsResponse{
 		Page:  page,
@@ -191,7 +197,7 @@ func (k Querier) QueryFirstPageHomePosts(goCtx context.Context, req *types.Query
 	}
 
 	// Use batch query for better performance
-	postResponses, err := k.batchGetPostsWithProfiles(ctx, postIDs)
+	postResponses, err := k.batchGetPostsWithProfiles(ctx, "", postIDs)
 	if err != nil {
 		types.LogError(k.logger, "batchGetPostsWithProfiles", err, "operation", "QueryFirstPageHomePosts")
 		return nil, types.ToGRPCError(types.ErrDatabaseOperation)
@@ -199,7 +205,7 @@ func (k Querier) QueryFirstPageHomePosts(goCtx context.Context, req *types.Query
 
 	return &types.QueryFirstPageHomePostsResponse{
 		Page:  page,
-		Posts: postResponses,
+		Posts: k.filterPrivatePosts(ctx, "", postResponses),
 	}, nil
 }
 
@@ -212,13 +218,22 @@ func (k Querier) QueryTopicPosts(goCtx context.Context, req *types.QueryTopicPos
 	if err != nil {
 		return nil, types.ToGRPCError(err)
 	}
+	// the pinned posts lead the first page
+	var pinned []string
+	if req.Page <= 1 {
+		pinned = k.activePins(ctx, k.GetTopicPins(ctx, req.TopicId))
+		postIDs = prependPinned(pinned, postIDs)
+	}
 
 	// Apply batch optimization for posts and profiles
-	postResponses, err := k.batchGetPostsWithProfiles(ctx, postIDs)
+	postResponses, err := k.batchGetPostsWithProfiles(ctx, req.Viewer, postIDs)
 	if err != nil {
 		types.LogError(k.logger, "batchGetPostsWithProfiles", err, "operation", "QueryTopicPosts")
 		return nil, types.ToGRPCError(types.ErrDatabaseOperation)
 	}
+	markPinned(postResponses, pinned)
+	postResponses = filterLabeledPosts(k.filterPrivatePosts(ctx, "", postResponses), k.excludedLabels(ctx, req.Viewer, req.ExcludeLabels))
+	postResponses = k.withReportCounts(ctx, req.Viewer, k.filterMutedPosts(ctx, req.Viewer, postResponses))
 
 	return &types.QueryTopicPostsResponse{
 		Page:  page,
@@ -226,6 +241,52 @@ func (k Querier) QueryTopicPosts(goCtx context.Context, req *types.QueryTopicPos
 	}, nil
 }
 
+// activePins drops the pins of posts that are gone or taken down.
+func (k Querier) activePins(ctx sdk.Context, pinned []string) []string {
+	active := make([]string, 0, len(pinned))
+	for _, postID := range pinned {
+		if post, found := k.GetPost(ctx, postID); found && post.TakedownStatus == types.TakedownStatus_TAKEDOWN_STATUS_NONE {
+			active = append(active, postID)
+		}
+	}
+	return active
+}
+
+// prependPinned puts the pinned posts in front of postIDs, dropping them from
+// the rest of the list.
+func prependPinned(pinned []string, postIDs []string) []string {
+	if len(pinned) == 0 {
+		return postIDs
+	}
+	isPinned := make(map[string]bool, len(pinned))
+	for _, postID := range pinned {
+		isPinned[postID] = true
+	}
+	ids := append([]string{}, pinned...)
+	for _, postID := range postIDs {
+		if !isPinned[postID] {
+			ids = append(ids, postID)
+		}
+	}
+	return ids
+}
+
+// markPinned flags the responses of the pinned posts.
+func markPinned(postResponses []*types.PostResponse, pinned []string) {
+	if len(pinned) == 0 {
+		return
+	}
+	isPinned := make(map[string]bool, len(pinned))
+	for _, postID := range pinned {
+		isPinned[postID] = true
+	}
+	for _, postResponse := range postResponses {
+		if postResponse.Post != nil && isPinned[postResponse.Post.Id] {
+			postResponse.Pinned = true
+		}
+	}
+}
+
 // QueryUserCreatedPosts implements types.QueryServer.
 func (k Querier) QueryUserCreatedPosts(goCtx context.Context, req *types.QueryUserCreatedPostsRequest) (*types.QueryUserCreatedPostsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -236,13 +297,31 @@ func (k Querier) QueryUserCreatedPosts(goCtx context.Context, req *types.QueryUs
 		types.LogError(k.logger, "get_user_created_posts", err, "address", req.Address)
 		return nil, types.ToGRPCError(types.WrapError(types.ErrDatabaseOperation, "failed to get user created posts"))
 	}
+	// the pinned posts lead the first page
+	var pinned []string
+	if req.Page <= 1 {
+		pinned = k.activePins(ctx, k.GetUserPins(ctx, req.Address))
+		postIDs = prependPinned(pinned, postIDs)
+	}
 
 	// Use batch processing to reduce database queries
-	postResponses, err := k.batchGetPostsWithProfiles(ctx, postIDs)
+	postResponses, err := k.batchGetPostsWithProfiles(ctx, req.Viewer, postIDs)
 	if err != nil {
 		types.LogError(k.logger, "batch_get_posts_with_profiles", err, "post_count", len(postIDs))
 		return nil, types.ToGRPCError(types.WrapError(types.ErrDatabaseOperation, "failed to retrieve posts and profiles"))
 	}
+	markPinned(postResponses, pinned)
+	postResponses = k.filterPrivatePosts(ctx, req.Viewer, postResponses)
+
+	// posts of other creators are in the list because the user reposted them
+	for _, postResponse := range postResponses {
+		if postResponse.Post.Creator == req.Address {
+			continue
+		}
+		if repost, found := k.GetRepost(ctx, req.Address, postResponse.Post.Id); found {
+			postResponse.Repost = &repost
+		}
+	}
 
 	return &types.QueryUserCreatedPostsResponse{
 		Page:  page,
@@ -280,7 +359,8 @@ func (k Querier) QueryPost(goCtx context.Context, req *types.QueryPostRequest) (
 
 	// Retrieve the post from the state
 	post, found := k.Keeper.GetPost(sdk.UnwrapSDKContext(goCtx), req.PostId)
-	if !found {
+	// a post outside the viewer's audience is reported as missing
+	if !found || !k.Keeper.CanViewPost(ctx, req.Viewer, post) {
 		return nil, types.ToGRPCError(types.NewPostNotFoundError(req.PostId))
 	}
 	postCopy := post
@@ -291,6 +371,7 @@ func (k Querier) QueryPost(goCtx context.Context, req *types.QueryPostRequest) (
 		Post:    &postCopy,
 		Profile: &profileResponseCopy,
 	}
+	k.withReportCounts(ctx, req.Viewer, []*types.PostResponse{&postResponse})
 
 	topics := k.Keeper.GetTopicsByPostId(ctx, post.Id)
 	var topicResponses []*types.TopicResponse
@@ -314,9 +395,11 @@ func (k Querier) QueryPost(goCtx context.Context, req *types.QueryPostRequest) (
 
 	if post.Quote != "" {
 		quotePost, _ := k.GetPost(ctx, post.Quote)
-		quoteProfile, _ := k.ProfileKeeper.GetProfile(ctx, quotePost.Creator)
-		postResponse.QuotePost = &quotePost
-		postResponse.QuoteProfile = &quoteProfile
+		if k.canViewQuote(ctx, req.Viewer, quotePost) {
+			quoteProfile, _ := k.ProfileKeeper.GetProfile(ctx, quotePost.Creator)
+			postResponse.QuotePost = &quotePost
+			postResponse.QuoteProfile = &quoteProfile
+		}
 	}
 	return &types.QueryPostResponse{
 		Post:   &postResponse,
@@ -370,7 +453,7 @@ func (k Querier) LikesIMade(ctx context.Context, request *types.LikesIMadeReques
 		postIDs = append(postIDs, likesIMade.PostId)
 	}
 
-	postResponses, err := k.batchGetPostsWithProfiles(sdkCtx, postIDs)
+	postResponses, err := k.batchGetPostsWithProfiles(sdkCtx, request.Viewer, postIDs)
 	if err != nil {
 		types.LogError(k.logger, "batch_get_posts_with_profiles", err, "address", request.Address)
 		return nil, types.ToGRPCError(types.WrapError(types.ErrDatabaseOperation, "failed to get posts with profiles"))
@@ -378,7 +461,7 @@ func (k Querier) LikesIMade(ctx context.Context, request *types.LikesIMadeReques
 
 	return &types.LikesIMadeResponse{
 		Page:  page,
-		Posts: postResponses,
+		Posts: k.filterPrivatePosts(sdkCtx, request.Viewer, postResponses),
 	}, nil
 }
 
@@ -400,7 +483,7 @@ func (k Querier) SavesIMade(ctx context.Context, request *types.SavesIMadeReques
 		postIDs = append(postIDs, savesIMade.PostId)
 	}
 
-	postResponses, err := k.batchGetPostsWithProfiles(sdkCtx, postIDs)
+	postResponses, err := k.batchGetPostsWithProfiles(sdkCtx, request.Viewer, postIDs)
 	if err != nil {
 		types.LogError(k.logger, "batch_get_posts_with_profiles", err, "address", request.Address)
 		return nil, types.ToGRPCError(types.WrapError(types.ErrDatabaseOperation, "failed to get posts with profiles"))
@@ -408,7 +491,7 @@ func (k Querier) SavesIMade(ctx context.Context, request *types.SavesIMadeReques
 
 	return &types.SavesIMadeResponse{
 		Page:  page,
-		Posts: postResponses,
+		Posts: k.filterPrivatePosts(sdkCtx, request.Viewer, postResponses),
 	}, nil
 }
 
@@ -433,6 +516,9 @@ func (k Querier) LikesReceived(ctx context.Context, request *types.LikesReceived
 // QueryComments implements types.QueryServer.
 func (k Querier) QueryComments(goCtx context.Context, req *types.QueryCommentsRequest) (*types.QueryCommentsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if parent, found := k.GetPost(ctx, req.Id); found && !k.Keeper.CanViewPost(ctx, req.Viewer, parent) {
+		return nil, types.ToGRPCError(types.NewPostNotFoundError(req.Id))
+	}
 	ids, _, page, err := k.Keeper.GetCommentsByParentId(ctx, req.Id, req.Page)
 	if err != nil {
 		types.LogError(k.logger, "get_comments_by_parent_id", err, "parent_id", req.Id)
@@ -488,6 +574,9 @@ func (k Querier) QueryCommentsReceived(goCtx context.Context, req *types.QueryCo
 		}
 		commentCopy := comment
 		postParent, _ := k.GetPost(ctx, comment.ParentId)
+		if !k.canViewPost(ctx, req.Viewer, postParent) {
+			continue
+		}
 		postParentCopy := postParent
 		CommentReceivedResponse := types.CommentReceivedResponse{
 			Comment: &commentCopy,
@@ -510,8 +599,13 @@ func (k Querier) QueryActivitiesReceived(goCtx context.Context, req *types.Query
 		profileTypes.LogError(k.ProfileKeeper.Logger(), "get_activities_received", err, "address", req.Address)
 		return nil, profileTypes.ToGRPCError(profileTypes.WrapError(profileTypes.ErrDatabaseOperation, "failed to get activities received"))
 	}
+	mutes := k.ProfileKeeper.GetMutes(ctx, req.Address)
 	var activitiesReceivedList []*types.ActivitiesReceivedResponse
 	for _, activitiesReceived := range list {
+		// activities of muted accounts or with muted keywords are not listed
+		if slices.Contains(mutes.Accounts, activitiesReceived.Address) || containsMutedKeyword(activitiesReceived.Content, mutes.Keywords) {
+			continue
+		}
 		activitiesReceivedResponse := types.ActivitiesReceivedResponse{
 			Address:        activitiesReceived.Address,
 			TargetAddress:  activitiesReceived.TargetAddress,
@@ -526,7 +620,11 @@ func (k Querier) QueryActivitiesReceived(goCtx context.Context, req *types.Query
 		parentId := activitiesReceived.ParentId
 		if parentId != "" {
 			parentPost, _ := k.GetPost(ctx, parentId)
-			activitiesReceivedResponse.ParentPost = &parentPost
+			if k.canViewPost(ctx, req.Viewer, parentPost) {
+				activitiesReceivedResponse.ParentPost = &parentPost
+			} else {
+				activitiesReceivedResponse.ParentImageUrl = ""
+			}
 		}
 
 		address := activitiesReceived.Address
@@ -677,15 +775,17 @@ func (k Querier) QueryCategoryPosts(goCtx context.Context, req *types.QueryCateg
 		}
 		if post.Quote != "" {
 			quotePost, _ := k.GetPost(ctx, post.Quote)
-			quoteProfile, _ := k.ProfileKeeper.GetProfile(ctx, quotePost.Creator)
-			postResponse.QuotePost = &quotePost
-			postResponse.QuoteProfile = &quoteProfile
+			if k.canViewQuote(ctx, req.Viewer, quotePost) {
+				quoteProfile, _ := k.ProfileKeeper.GetProfile(ctx, quotePost.Creator)
+				postResponse.QuotePost = &quotePost
+				postResponse.QuoteProfile = &quoteProfile
+			}
 		}
 		postResponseList = append(postResponseList, &postResponse)
 	}
 	categoryPostsResponse := types.CategoryPostsResponse{
 		Category: &categoryResponse,
-		Posts:    postResponseList,
+		Posts:    k.withReportCounts(ctx, req.Viewer, filterLabeledPosts(k.filterPrivatePosts(ctx, "", postResponseList), k.excludedLabels(ctx, req.Viewer, req.ExcludeLabels))),
 	}
 
 	return &types.QueryCategoryPostsResponse{
@@ -736,9 +836,11 @@ func (k Querier) QueryFollowingPosts(goCtx context.Context, req *types.QueryFoll
 				}
 				if post.Quote != "" {
 					quotePost, _ := k.GetPost(ctx, post.Quote)
-					quoteProfile, _ := k.ProfileKeeper.GetProfile(ctx, quotePost.Creator)
-					response.QuotePost = &quotePost
-					response.QuoteProfile = &quoteProfile
+					if k.canViewQuote(ctx, req.Address, quotePost) {
+						quoteProfile, _ := k.ProfileKeeper.GetProfile(ctx, quotePost.Creator)
+						response.QuotePost = &quotePost
+						response.QuoteProfile = &quoteProfile
+					}
 				}
 				postResponses = append(postResponses, &response)
 			}
@@ -761,10 +863,138 @@ func (k Querier) QueryFollowingPosts(goCtx context.Context, req *types.QueryFoll
 		return postResponses[i].Post.Timestamp > postResponses[j].Post.Timestamp
 	})
 	return &types.QueryFollowingPostsResponse{
-		Posts: postResponses,
+		Posts: k.withReportCounts(ctx, address, k.filterMutedPosts(ctx, address, filterLabeledPosts(k.filterPrivatePosts(ctx, address, postResponses), k.excludedLabels(ctx, address, req.ExcludeLabels)))),
 	}, nil
 }
 
+// excludedLabels returns the labels a feed request filters out, falling back
+// to the viewer's default filter when the request sets none.
+func (k Querier) excludedLabels(ctx sdk.Context, viewer string, requested []string) []string {
+	if len(requested) > 0 || viewer == "" {
+		return requested
+	}
+	profile, _ := k.ProfileKeeper.GetProfile(ctx, viewer)
+	return profile.ExcludedLabels
+}
+
+// withReportCounts sets the open report counts when the viewer is a moderator.
+func (k Querier) withReportCounts(ctx sdk.Context, viewer string, postResponses []*types.PostResponse) []*types.PostResponse {
+	if viewer == "" || !k.IsModerator(ctx, viewer) {
+		return postResponses
+	}
+	for _, postResponse := range postResponses {
+		if postResponse.Post != nil {
+			postResponse.ReportCount = k.GetReportCount(ctx, postResponse.Post.Id)
+		}
+	}
+	return postResponses
+}
+
+// filterMutedPosts drops the posts of the accounts, topics and keywords the
+// viewer has muted, the viewer's own posts are always kept.
+func (k Querier) filterMutedPosts(ctx sdk.Context, viewer string, postResponses []*types.PostResponse) []*types.PostResponse {
+	if viewer == "" {
+		return postResponses
+	}
+	mutes := k.ProfileKeeper.GetMutes(ctx, viewer)
+	if len(mutes.Accounts) == 0 && len(mutes.Topics) == 0 && len(mutes.Keywords) == 0 {
+		return postResponses
+	}
+	filtered := make([]*types.PostResponse, 0, len(postResponses))
+	for _, postResponse := range postResponses {
+		if postResponse.Post != nil && postResponse.Post.Creator != viewer {
+			if k.isMutedPost(ctx, mutes, *postResponse.Post) {
+				continue
+			}
+			if postResponse.QuotePost != nil && slices.Contains(mutes.Accounts, postResponse.QuotePost.Creator) {
+				continue
+			}
+		}
+		filtered = append(filtered, postResponse)
+	}
+	return filtered
+}
+
+// filterPrivatePosts drops the posts of private accounts the viewer does not
+// follow and the restricted posts outside the viewer's audience, the public
+// feeds pass no viewer to leave out every private post.
+func (k Querier) filterPrivatePosts(ctx sdk.Context, viewer string, postResponses []*types.PostResponse) []*types.PostResponse {
+	visible := make(map[string]bool)
+	filtered := make([]*types.PostResponse, 0, len(postResponses))
+	for _, postResponse := range postResponses {
+		if postResponse.Post == nil {
+			filtered = append(filtered, postResponse)
+			continue
+		}
+		creator := postResponse.Post.Creator
+		canView, checked := visible[creator]
+		if !checked {
+			canView = k.ProfileKeeper.CanViewPosts(ctx, viewer, creator)
+			visible[creator] = canView
+		}
+		if canView && k.Keeper.CanViewPost(ctx, viewer, *postResponse.Post) {
+			filtered = append(filtered, postResponse)
+		}
+	}
+	return filtered
+}
+
+// canViewPost reports whether viewer may see post, checking both the audience
+// of the post and the privacy of its creator.
+func (k Querier) canViewPost(ctx sdk.Context, viewer string, post types.Post) bool {
+	return k.ProfileKeeper.CanViewPosts(ctx, viewer, post.Creator) && k.Keeper.CanViewPost(ctx, viewer, post)
+}
+
+// canViewQuote reports whether a quoted post is shown to viewer, taken down
+// posts are not shown to anyone.
+func (k Querier) canViewQuote(ctx sdk.Context, viewer string, quote types.Post) bool {
+	return quote.TakedownStatus == types.TakedownStatus_TAKEDOWN_STATUS_NONE && k.canViewPost(ctx, viewer, quote)
+}
+
+// isMutedPost reports whether post is from a muted account, in a muted topic
+// or contains a muted keyword.
+func (k Querier) isMutedPost(ctx sdk.Context, mutes profileTypes.MuteList, post types.Post) bool {
+	if slices.Contains(mutes.Accounts, post.Creator) || containsMutedKeyword(post.Content, mutes.Keywords) {
+		return true
+	}
+	if len(mutes.Topics) > 0 {
+		for _, topicHash := range k.GetTopicsByPostId(ctx, post.Id) {
+			if slices.Contains(mutes.Topics, topicHash) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containsMutedKeyword matches the muted keywords, stored lowercased, against text.
+func containsMutedKeyword(text string, keywords []string) bool {
+	if len(keywords) == 0 || text == "" {
+		return false
+	}
+	lower := strings.ToLower(text)
+	return slices.ContainsFunc(keywords, func(keyword string) bool {
+		return strings.Contains(lower, keyword)
+	})
+}
+
+// filterLabeledPosts drops the posts carrying one of the excluded labels.
+func filterLabeledPosts(postResponses []*types.PostResponse, excluded []string) []*types.PostResponse {
+	if len(excluded) == 0 {
+		return postResponses
+	}
+	filtered := make([]*types.PostResponse, 0, len(postResponses))
+	for _, postResponse := range postResponses {
+		if postResponse.Post != nil && slices.ContainsFunc(postResponse.Post.Labels, func(label string) bool {
+			return slices.Contains(excluded, label)
+		}) {
+			continue
+		}
+		filtered = append(filtered, postResponse)
+	}
+	return filtered
+}
+
 func isToday(ctx sdk.Context, postTimestamp int64) bool {
 	postTime := time.Unix(postTimestamp, 0).UTC()
 	blockTime := ctx.BlockTime().UTC()
@@ -813,6 +1043,51 @@ func (k Querier) QueryFollowingTopics(goCtx context.Context, req *types.QueryFol
 	}, nil
 }
 
+// QueryListPosts implements types.QueryServer.
+func (k Querier) QueryListPosts(goCtx context.Context, req *types.QueryListPostsRequest) (*types.QueryListPostsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	list, found := k.ProfileKeeper.GetUserList(ctx, req.ListId)
+	if !found || !list.VisibleTo(req.Viewer) {
+		return nil, types.ToGRPCError(types.WrapErrorf(types.ErrResourceNotFound, "list %d not found", req.ListId))
+	}
+
+	postIDs, page := k.Keeper.GetListPosts(ctx, k.ProfileKeeper.GetListMembers(ctx, list.Id), req.Page)
+	postResponses, err := k.batchGetPostsWithProfiles(ctx, req.Viewer, postIDs)
+	if err != nil {
+		types.LogError(k.logger, "batchGetPostsWithProfiles", err, "operation", "QueryListPosts")
+		return nil, types.ToGRPCError(types.ErrDatabaseOperation)
+	}
+	postResponses = filterLabeledPosts(k.filterPrivatePosts(ctx, req.Viewer, postResponses), k.excludedLabels(ctx, req.Viewer, req.ExcludeLabels))
+	postResponses = k.withReportCounts(ctx, req.Viewer, k.filterMutedPosts(ctx, req.Viewer, postResponses))
+
+	return &types.QueryListPostsResponse{
+		Page:  page,
+		Posts: postResponses,
+	}, nil
+}
+
+// QueryFollowSuggestions implements types.QueryServer.
+func (k Querier) QueryFollowSuggestions(goCtx context.Context, req *types.QueryFollowSuggestionsRequest) (*types.QueryFollowSuggestionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Viewer == "" {
+		return nil, types.ToGRPCError(types.NewInvalidRequestError("viewer is required"))
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.PageSize
+	}
+	if limit > types.MaxFollowSuggestions {
+		limit = types.MaxFollowSuggestions
+	}
+
+	suggestions := k.Keeper.GetFollowSuggestions(ctx, req.Viewer, int(limit))
+	res := &types.QueryFollowSuggestionsResponse{}
+	for i := range suggestions {
+		res.Suggestions = append(res.Suggestions, &suggestions[i])
+	}
+	return res, nil
+}
+
 // QueryIsFollowingTopic implements types.QueryServer.
 func (k Querier) QueryIsFollowingTopic(goCtx context.Context, req *types.QueryIsFollowingTopicRequest) (*types.QueryIsFollowingTopicResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -859,9 +1134,14 @@ func (k Querier) QueryUncategorizedTopics(goCtx context.Context, req *types.Quer
 // QueryVoteOption implements types.QueryServer.
 func (k Querier) QueryVoteOption(goCtx context.Context, req *types.QueryVoteOptionRequest) (*types.QueryVoteOptionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	optionId, _ := k.GetPoll(ctx, req.PostId, req.Address)
+	optionIds, _ := k.GetPoll(ctx, req.PostId, req.Address)
+	optionId := ""
+	if len(optionIds) > 0 {
+		optionId = strconv.FormatInt(optionIds[0], 10)
+	}
 	return &types.QueryVoteOptionResponse{
-		OptionId: optionId,
+		OptionId:  optionId,
+		OptionIds: optionIds,
 	}, nil
 }
 
@@ -971,3 +1251,168 @@ func (k Querier) QueryPaidPostImage(goCtx context.Context, req *types.QueryPaidP
 		Image: image,
 	}, nil
 }
+
+// QueryPostRevisions implements types.QueryServer.
+func (k Querier) QueryPostRevisions(goCtx context.Context, req *types.QueryPostRevisionsRequest) (*types.QueryPostRevisionsResponse, error) {
+	if req == nil || strings.TrimSpace(req.PostId) == "" {
+		return nil, types.ToGRPCError(types.ErrInvalidRequest)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if post, found := k.GetPost(ctx, req.PostId); found && !k.Keeper.CanViewPost(ctx, req.Viewer, post) {
+		return nil, types.ToGRPCError(types.NewPostNotFoundError(req.PostId))
+	}
+
+	revisions, _, page, err := k.Keeper.GetPostRevisions(ctx, req.PostId, req.Page)
+	if err != nil {
+		return nil, types.ToGRPCError(err)
+	}
+
+	return &types.QueryPostRevisionsResponse{
+		Page:      page,
+		Revisions: revisions,
+	}, nil
+}
+
+// QueryCommentThread implements types.QueryServer.
+func (k Querier) QueryCommentThread(goCtx context.Context, req *types.QueryCommentThreadRequest) (*types.QueryCommentThreadResponse, error) {
+	if req == nil || strings.TrimSpace(req.Id) == "" {
+		return nil, types.ToGRPCError(types.ErrInvalidRequest)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	parent, found := k.GetPost(ctx, req.Id)
+	if !found || !k.Keeper.CanViewPost(ctx, req.Viewer, parent) {
+		return nil, types.ToGRPCError(types.NewPostNotFoundError(req.Id))
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.PageSize
+	}
+	if limit > types.CommentThreadMaxLimit {
+		limit = types.CommentThreadMaxLimit
+	}
+	maxDepth := req.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = types.CommentThreadDefaultDepth
+	}
+	if maxDepth > types.CommentThreadMaxDepth {
+		maxDepth = types.CommentThreadMaxDepth
+	}
+	cursor, err := base64.RawURLEncoding.DecodeString(req.Cursor)
+	if err != nil {
+		return nil, types.ToGRPCError(types.NewInvalidRequestError("invalid cursor"))
+	}
+
+	budget := uint64(types.CommentThreadMaxNodes)
+	replies, nextCursor, err := k.commentThreadLevel(ctx, parent, cursor, limit, maxDepth, &budget)
+	if err != nil {
+		return nil, types.ToGRPCError(err)
+	}
+	return &types.QueryCommentThreadResponse{
+		Replies:    replies,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// commentThreadLevel returns the first replies of parent after cursor and
+// expands each of them until depth levels have been returned. Every returned
+// comment is taken from budget, which is shared by the whole thread; once it
+// is used up the remaining replies are reached through the cursors, or with
+// the comment id for a comment whose replies were not expanded.
+func (k Querier) commentThreadLevel(ctx sdk.Context, parent types.Post, cursor []byte, limit uint64, depth uint64, budget *uint64) ([]*types.CommentThreadNode, string, error) {
+	if limit > *budget {
+		limit = *budget
+	}
+	if limit == 0 {
+		return nil, "", nil
+	}
+	ids, nextKey, err := k.Keeper.GetCommentRepliesPage(ctx, parent.Id, cursor, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	*budget -= uint64(len(ids))
+	targetProfile, _ := k.ProfileKeeper.GetProfile(ctx, parent.Creator)
+
+	nodes := make([]*types.CommentThreadNode, 0, len(ids))
+	for _, commentId := range ids {
+		comment, found := k.GetPost(ctx, commentId)
+		if !found {
+			types.LogError(k.logger, "get_comment_for_thread", types.ErrPostNotFound, "comment_id", commentId)
+			continue
+		}
+		profile, _ := k.ProfileKeeper.GetProfile(ctx, comment.Creator)
+		targetProfileCopy := targetProfile
+		node := &types.CommentThreadNode{
+			Comment: &types.CommentResponse{
+				Post:          &comment,
+				Profile:       &profile,
+				TargetProfile: &targetProfileCopy,
+			},
+		}
+		// below the last level the comment count tells the client that
+		// replies can be loaded with the comment id
+		if depth > 1 && comment.CommentCount > 0 && *budget > 0 {
+			node.Replies, node.MoreRepliesCursor, err = k.commentThreadLevel(ctx, comment, nil, limit, depth-1, budget)
+			if err != nil {
+				return nil, "", err
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, base64.RawURLEncoding.EncodeToString(nextKey), nil
+}
+
+// QueryScheduledPosts implements types.QueryServer.
+func (k Querier) QueryScheduledPosts(goCtx context.Context, req *types.QueryScheduledPostsRequest) (*types.QueryScheduledPostsResponse, error) {
+	if req == nil || strings.TrimSpace(req.Creator) == "" {
+		return nil, types.ToGRPCError(types.ErrInvalidRequest)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scheduledPosts, _, page, err := k.Keeper.GetScheduledPostsByCreator(ctx, req.Creator, req.Page)
+	if err != nil {
+		return nil, types.ToGRPCError(err)
+	}
+	return &types.QueryScheduledPostsResponse{
+		Page:           page,
+		ScheduledPosts: scheduledPosts,
+	}, nil
+}
+
+// QueryReports implements types.QueryServer.
+// The queue is public, a query cannot authenticate its caller, so every report
+// is returned without its reporter.
+func (k Querier) QueryReports(goCtx context.Context, req *types.QueryReportsRequest) (*types.QueryReportsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	postIds := []string{req.PostId}
+	page := uint64(1)
+	if req.PostId == "" {
+		var err error
+		postIds, _, page, err = k.GetReportQueue(ctx, req.Page)
+		if err != nil {
+			return nil, types.ToGRPCError(err)
+		}
+	}
+
+	var reportedPosts []*types.ReportedPost
+	for _, postId := range postIds {
+		reports := k.GetReportsByPostId(ctx, postId)
+		if len(reports) == 0 {
+			continue
+		}
+		reportedPost := &types.ReportedPost{
+			PostId:      postId,
+			ReportCount: k.GetReportCount(ctx, postId),
+		}
+		for i := range reports {
+			reports[i].Reporter = ""
+			reportedPost.Reports = append(reportedPost.Reports, &reports[i])
+		}
+		reportedPosts = append(reportedPosts, reportedPost)
+	}
+	return &types.QueryReportsResponse{
+		Page:          page,
+		ReportedPosts: reportedPosts,
+	}, nil
+}
@@ -3,11 +3,13 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -22,7 +24,7 @@ import (
 
 const (
 	// ConsensusVersion defines the current x/post module consensus version.
-	ConsensusVersion = 1
+	ConsensusVersion = 2
 )
 
 var (
@@ -31,6 +33,7 @@ var (
 	_ module.AppModule        = AppModule{}
 
 	_ autocli.HasAutoCLIConfig = AppModule{}
+	_ appmodule.HasEndBlocker  = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -74,7 +77,7 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEn
 	if err := data.Params.Validate(); err != nil {
 		return errorsmod.Wrap(err, "params")
 	}
-	return nil
+	return data.Validate()
 }
 
 func (a AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {
@@ -111,7 +114,7 @@ func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, messa
 	var genesisState types.GenesisState
 	marshaler.MustUnmarshalJSON(message, &genesisState)
 
-	if err := a.keeper.Params.Set(ctx, genesisState.Params); err != nil {
+	if err := a.keeper.InitGenesis(ctx, &genesisState); err != nil {
 		panic(err)
 	}
 
@@ -126,6 +129,11 @@ func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) jso
 func (a AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
 }
 
+// EndBlock publishes the scheduled posts that are due.
+func (a AppModule) EndBlock(ctx context.Context) error {
+	return a.keeper.EndBlocker(ctx)
+}
+
 func (a AppModule) QuerierRoute() string {
 	return types.QuerierRoute
 }
@@ -133,6 +141,11 @@ func (a AppModule) QuerierRoute() string {
 func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(a.keeper, a.keeper.ProfileKeeper))
+
+	m := keeper.NewMigrator(a.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the
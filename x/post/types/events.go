@@ -6,6 +6,7 @@ const (
 	EventTypeCreatePaidPost          = "create_paid_post"
 	EventTypeQuotePost               = "quote_post"
 	EventTypeRepost                  = "repost"
+	EventTypeUndoRepost              = "undo_repost"
 	EventTypeComment                 = "comment"
 	EventTypeLikePost                = "like_post"
 	EventTypeUnlikePost              = "unlike_post"
@@ -14,6 +15,25 @@ const (
 	EventTypeAddCategory             = "add_category"
 	EventTypeDeleteCategory          = "delete_category"
 	EventTypeUpdateTopicCategory     = "update_topic_category"
+	EventTypeEditPost                = "edit_post"
+	EventTypeEditComment             = "edit_comment"
+	EventTypeSchedulePost            = "schedule_post"
+	EventTypePublishScheduledPost    = "publish_scheduled_post"
+	EventTypeCancelScheduledPost     = "cancel_scheduled_post"
+	EventTypePollClosed              = "poll_closed"
+	EventTypeAddPostLabels           = "add_post_labels"
+	EventTypeReportPost              = "report_post"
+	EventTypeResolveReport           = "resolve_report"
+	EventTypeTakedownPost            = "takedown_post"
+	EventTypeAppealTakedown          = "appeal_takedown"
+	EventTypeDecideAppeal            = "decide_appeal"
+	EventTypeClaimTopic              = "claim_topic"
+	EventTypeSetTopicAdmin           = "set_topic_admin"
+	EventTypePinTopicPost            = "pin_topic_post"
+	EventTypeUnpinTopicPost          = "unpin_topic_post"
+	EventTypeRemoveTopicPost         = "remove_topic_post"
+	EventTypePinPost                 = "pin_post"
+	EventTypeUnpinPost               = "unpin_post"
 
 	AttributeKeyCreator       = "creator"
 	AttributeKeyPostID        = "post_id"
@@ -25,4 +45,15 @@ const (
 	AttributeKeyTopicID       = "topic_id"
 	AttributeKeyOldCategoryID = "old_category_id"
 	AttributeKeyNewCategoryID = "new_category_id"
+	AttributeKeyScheduledID   = "scheduled_id"
+	AttributeKeyPublishAt     = "publish_at"
+	AttributeKeyError         = "error"
+	AttributeKeyTotalVotes    = "total_votes"
+	AttributeKeyTally         = "tally"
+	AttributeKeyLabels        = "labels"
+	AttributeKeyReason        = "reason"
+	AttributeKeyOutcome       = "outcome"
+	AttributeKeyReportCount   = "report_count"
+	AttributeKeyRestored      = "restored"
+	AttributeKeyAdmin         = "admin"
 )
@@ -14,6 +14,252 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	posts := make(map[string]Post, len(gs.Posts))
+	for _, post := range gs.Posts {
+		if post.Id == "" {
+			return WrapError(ErrInvalidGenesis, "post id cannot be empty")
+		}
+		if _, ok := posts[post.Id]; ok {
+			return WrapErrorf(ErrInvalidGenesis, "duplicate post %s", post.Id)
+		}
+		if err := ValidateLabels(post.Labels); err != nil {
+			return WrapErrorf(ErrInvalidGenesis, "post %s: %s", post.Id, err)
+		}
+		if post.TakedownStatus != TakedownStatus_TAKEDOWN_STATUS_NONE && post.Takedown == nil {
+			return WrapErrorf(ErrInvalidGenesis, "taken down post %s has no takedown record", post.Id)
+		}
+		posts[post.Id] = post
+	}
+	for _, post := range gs.Posts {
+		if post.PostType != PostType_COMMENT {
+			continue
+		}
+		if _, ok := posts[post.ParentId]; !ok {
+			return WrapErrorf(ErrInvalidGenesis, "comment %s references missing parent %s", post.Id, post.ParentId)
+		}
+	}
+
+	categories := make(map[string]bool, len(gs.Categories))
+	for _, category := range gs.Categories {
+		if category.Id == "" || categories[category.Id] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate category %q", category.Id)
+		}
+		categories[category.Id] = true
+	}
+	topics := make(map[string]bool, len(gs.Topics))
+	for _, topic := range gs.Topics {
+		if topic.Id == "" || topics[topic.Id] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate topic %q", topic.Id)
+		}
+		topics[topic.Id] = true
+	}
+
+	hasPost := func(field string, postId string) error {
+		if _, ok := posts[postId]; !ok {
+			return WrapErrorf(ErrInvalidGenesis, "%s references missing post %s", field, postId)
+		}
+		return nil
+	}
+	hasTopic := func(field string, topicId string) error {
+		if !topics[topicId] {
+			return WrapErrorf(ErrInvalidGenesis, "%s references missing topic %s", field, topicId)
+		}
+		return nil
+	}
+	hasCategory := func(field string, categoryId string) error {
+		if !categories[categoryId] {
+			return WrapErrorf(ErrInvalidGenesis, "%s references missing category %s", field, categoryId)
+		}
+		return nil
+	}
+
+	for _, image := range gs.TopicImages {
+		if err := hasTopic("topic image", image.TopicId); err != nil {
+			return err
+		}
+	}
+	for _, mapping := range gs.TopicCategories {
+		if err := hasTopic("topic category", mapping.TopicId); err != nil {
+			return err
+		}
+		if err := hasCategory("topic category", mapping.CategoryId); err != nil {
+			return err
+		}
+	}
+	for _, topicId := range gs.UncategorizedTopics {
+		if err := hasTopic("uncategorized topics", topicId); err != nil {
+			return err
+		}
+	}
+	for _, topicId := range gs.TrendingTopics {
+		if err := hasTopic("trending topics", topicId); err != nil {
+			return err
+		}
+	}
+
+	for _, mapping := range gs.PostTopics {
+		if err := hasPost("post topics", mapping.PostId); err != nil {
+			return err
+		}
+		for _, topicId := range mapping.TopicIds {
+			if err := hasTopic("post topics", topicId); err != nil {
+				return err
+			}
+		}
+	}
+	for _, mapping := range gs.PostCategories {
+		if err := hasPost("post category", mapping.PostId); err != nil {
+			return err
+		}
+		if err := hasCategory("post category", mapping.CategoryId); err != nil {
+			return err
+		}
+	}
+
+	for _, postId := range gs.HomePosts {
+		if err := hasPost("home posts", postId); err != nil {
+			return err
+		}
+	}
+	for _, feed := range gs.TopicPosts {
+		if err := hasTopic("topic posts", feed.Id); err != nil {
+			return err
+		}
+		for _, postId := range feed.PostIds {
+			if err := hasPost("topic posts", postId); err != nil {
+				return err
+			}
+		}
+	}
+	for _, feed := range gs.CategoryPosts {
+		if err := hasCategory("category posts", feed.Id); err != nil {
+			return err
+		}
+		for _, postId := range feed.PostIds {
+			if err := hasPost("category posts", postId); err != nil {
+				return err
+			}
+		}
+	}
+	for _, entry := range gs.UserCreatedPosts {
+		if entry.Address == "" {
+			return WrapError(ErrInvalidGenesis, "user created posts entry without address")
+		}
+		if err := hasPost("user created posts", entry.PostId); err != nil {
+			return err
+		}
+	}
+
+	for _, like := range gs.Likes {
+		if err := hasPost("like", like.PostId); err != nil {
+			return err
+		}
+	}
+	for _, save := range gs.Saves {
+		if err := hasPost("save", save.PostId); err != nil {
+			return err
+		}
+	}
+	for _, vote := range gs.PollVotes {
+		if err := hasPost("poll vote", vote.PostId); err != nil {
+			return err
+		}
+		if posts[vote.PostId].Poll == nil {
+			return WrapErrorf(ErrInvalidGenesis, "poll vote references post %s without a poll", vote.PostId)
+		}
+		if len(vote.OptionIds) == 0 {
+			return WrapErrorf(ErrInvalidGenesis, "poll vote of %s on post %s has no options", vote.Voter, vote.PostId)
+		}
+	}
+	for _, follow := range gs.TopicFollows {
+		if err := hasTopic("topic follow", follow.TopicId); err != nil {
+			return err
+		}
+	}
+
+	for _, mapping := range gs.PostTxHashes {
+		if err := hasPost("post tx hash", mapping.PostId); err != nil {
+			return err
+		}
+	}
+	for _, revision := range gs.PostRevisions {
+		if err := hasPost("post revision", revision.PostId); err != nil {
+			return err
+		}
+	}
+	reposts := make(map[string]bool, len(gs.Reposts))
+	for _, repost := range gs.Reposts {
+		if repost.Reposter == "" {
+			return WrapError(ErrInvalidGenesis, "repost without reposter")
+		}
+		if err := hasPost("repost", repost.PostId); err != nil {
+			return err
+		}
+		key := repost.Reposter + "/" + repost.PostId
+		if reposts[key] {
+			return WrapErrorf(ErrInvalidGenesis, "duplicate repost %s", key)
+		}
+		reposts[key] = true
+	}
+	scheduledPosts := make(map[string]bool, len(gs.ScheduledPosts))
+	for _, scheduled := range gs.ScheduledPosts {
+		if scheduled.Id == "" || scheduledPosts[scheduled.Id] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate scheduled post %q", scheduled.Id)
+		}
+		scheduledPosts[scheduled.Id] = true
+		if scheduled.Creator == "" || scheduled.PostDetail == nil || scheduled.PublishAt <= 0 {
+			return WrapErrorf(ErrInvalidGenesis, "scheduled post %s needs a creator, a post and a publish time", scheduled.Id)
+		}
+	}
+	reports := make(map[string]bool, len(gs.Reports))
+	for _, report := range gs.Reports {
+		if err := hasPost("report", report.PostId); err != nil {
+			return err
+		}
+		key := report.PostId + "/" + report.Reporter
+		if report.Reporter == "" || reports[key] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate report %s", key)
+		}
+		reports[key] = true
+		if report.Reason == ReportReason_REPORT_REASON_UNSPECIFIED {
+			return WrapErrorf(ErrInvalidGenesis, "report %s has no reason", key)
+		}
+	}
+	for _, pin := range gs.TopicPins {
+		if err := hasTopic("topic pin", pin.TopicId); err != nil {
+			return err
+		}
+		if err := hasPost("topic pin", pin.PostId); err != nil {
+			return err
+		}
+	}
+	for _, pin := range gs.UserPins {
+		if err := hasPost("user pin", pin.PostId); err != nil {
+			return err
+		}
+		if posts[pin.PostId].Creator != pin.Address {
+			return WrapErrorf(ErrInvalidGenesis, "%s pinned post %s of another creator", pin.Address, pin.PostId)
+		}
+	}
+	creatorContents := make(map[string]bool, len(gs.CreatorContents))
+	for _, content := range gs.CreatorContents {
+		key := content.Creator + "/" + content.ContentHash
+		if content.Creator == "" || content.ContentHash == "" || creatorContents[key] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate creator content %s", key)
+		}
+		creatorContents[key] = true
+	}
+	repetitions := make(map[string]bool, len(gs.ContentRepetitions))
+	for _, content := range gs.ContentRepetitions {
+		if content.ContentHash == "" || repetitions[content.ContentHash] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate content repetition %q", content.ContentHash)
+		}
+		repetitions[content.ContentHash] = true
+	}
 
-	return gs.Params.Validate()
+	return nil
 }
@@ -24,6 +24,56 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "comment with parent",
+			genState: &types.GenesisState{
+				Posts: []types.Post{
+					{Id: "post", Creator: "alice"},
+					{Id: "comment", PostType: types.PostType_COMMENT, ParentId: "post", Creator: "bob"},
+				},
+				HomePosts: []string{"post"},
+			},
+			valid: true,
+		},
+		{
+			desc: "comment with missing parent",
+			genState: &types.GenesisState{
+				Posts: []types.Post{
+					{Id: "comment", PostType: types.PostType_COMMENT, ParentId: "post", Creator: "bob"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate post",
+			genState: &types.GenesisState{
+				Posts: []types.Post{{Id: "post"}, {Id: "post"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "like of missing post",
+			genState: &types.GenesisState{
+				Likes: []types.GenesisReaction{{Address: "alice", PostId: "post", Timestamp: 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "topic feed of missing topic",
+			genState: &types.GenesisState{
+				Posts:      []types.Post{{Id: "post"}},
+				TopicPosts: []types.GenesisFeed{{Id: "topic", PostIds: []string{"post"}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "user pin of another creator's post",
+			genState: &types.GenesisState{
+				Posts:    []types.Post{{Id: "post", Creator: "alice"}},
+				UserPins: []types.GenesisUserPin{{Address: "bob", PostId: "post"}},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -4,6 +4,8 @@ import (
 	"cosmossdk.io/collections"
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+
+	profiletypes "github.com/rollchains/tlock/x/profile/types"
 )
 
 var (
@@ -41,7 +43,33 @@ const (
 	UserCreatedPostsCount          = 100
 	UserCreatedPostsPageSize       = 10
 
-	CommentListKeyPrefix = "Post/comment/list/"
+	RepostKeyPrefix      = "Post/repost/user/"
+	PostRepostsKeyPrefix = "Post/repost/post/"
+
+	ScheduledPostKeyPrefix      = "Post/scheduled/value/"
+	ScheduledPostQueueKeyPrefix = "Post/scheduled/queue/"
+	UserScheduledPostsKeyPrefix = "Post/scheduled/user/"
+	ScheduledPostsPageSize      = 10
+	// MaxScheduleAhead is how far in the future, in seconds, a post can be scheduled
+	MaxScheduleAhead = 30 * 24 * 60 * 60
+	// MaxScheduledPostsPerBlock bounds the work of the EndBlocker, later posts wait for the next block
+	MaxScheduledPostsPerBlock = 100
+
+	// reports are kept per post, the queue holds each reported post once
+	// under the time of its first open report
+	PostReportKeyPrefix      = "Post/report/post/"
+	PostReportCountKeyPrefix = "Post/report/count/"
+	ReportQueueKeyPrefix     = "Post/report/queue/"
+	ReportsPageSize          = 10
+	MaxReportNoteLength      = 500
+	MaxTakedownReasonLength  = 500
+
+	CommentListKeyPrefix      = "Post/comment/list/"
+	CommentThreadMaxLimit     = 50
+	CommentThreadDefaultDepth = 3
+	CommentThreadMaxDepth     = 5
+	// CommentThreadMaxNodes bounds the comments one thread query returns over all levels
+	CommentThreadMaxNodes = 200
 
 	UserLikesPrefix  = "Post/user/likes/"
 	LikesIMadePrefix = "Post/likes/i/made/"
@@ -97,11 +125,61 @@ const (
 	CategoryOperatorKeyPrefix    = "Post/category/operator"
 
 	PollUserPrefix = "Post/poll/"
+	// PollCloseQueueKeyPrefix orders the open polls by votingEnd
+	PollCloseQueueKeyPrefix = "Post/poll_close/"
+	MaxPollsClosedPerBlock  = 100
+	// PollNotifyQueueKeyPrefix holds the finalized polls whose voters are
+	// still to be notified, along with the last voter notified
+	PollNotifyQueueKeyPrefix     = "Post/poll_notify/"
+	MaxPollNotificationsPerBlock = 100
 
 	FollowTopicPrefix     = "Post/follow/topic/"
 	FollowTopicTimePrefix = "Post/follow/topic/time/"
 
 	PostTxHashMappingKeyPrefix = "Post/txhash/mapping/"
+
+	// posts pinned by a topic admin, kept per topic under the pin time
+	TopicPinnedPostsKeyPrefix = "Post/pinned/topic/"
+	MaxTopicPinnedPosts       = 3
+	// posts pinned by their creator to the top of the profile
+	UserPinnedPostsKeyPrefix = "Post/pinned/user/"
+	DefaultMaxPinnedPosts    = 3
+	MaxPinnedPostsLimit      = 10
+
+	// recent content hashes, per creator and across the chain, queued under
+	// the time they leave the duplicate window
+	DuplicateUserKeyPrefix   = "Post/duplicate/user/"
+	DuplicateGlobalKeyPrefix = "Post/duplicate/global/"
+	DuplicateQueueKeyPrefix  = "Post/duplicate/queue/"
+	DefaultDuplicateWindow   = 24 * 60 * 60
+	DefaultDuplicateFlags    = 20
+	// MaxDuplicatePrunesPerBlock bounds the work of the EndBlocker
+	MaxDuplicatePrunesPerBlock = 100
+
+	// list timelines merge the latest posts of every member, deep pages are
+	// refused as they read page * page size posts per member
+	ListPostsPageSize = 10
+	MaxListPostsPage  = 10
+
+	// follow suggestions walk the newest follows of the viewer and of each
+	// followed account, and rank candidates by these weights plus their score
+	SuggestionFollowingScan = 50
+	SuggestionMutualWeight  = 10
+	SuggestionTopicWeight   = 5
+	MaxFollowSuggestions    = 50
+
+	// content labels a post can carry, kept in x/profile for the feed filters
+	LabelNSFW      = profiletypes.LabelNSFW
+	LabelSensitive = profiletypes.LabelSensitive
+	LabelSpoiler   = profiletypes.LabelSpoiler
+
+	PostRevisionKeyPrefix      = "Post/revision/"
+	PostRevisionCountKeyPrefix = "Post/revision_count/"
+
+	// posts and comments removed with replies left, the EndBlocker removes
+	// the replies a few per block
+	CommentDeleteQueueKeyPrefix = "Post/comment/delete_queue/"
+	MaxCommentsDeletedPerBlock  = 100
 )
 
 var ORMModuleSchema = ormv1alpha1.ModuleSchemaDescriptor{
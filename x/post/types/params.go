@@ -7,7 +7,10 @@ import (
 // DefaultParams returns default module parameters.
 func DefaultParams() Params {
 	return Params{
-		SomeValue: true,
+		SomeValue:              true,
+		MaxPinnedPosts:         DefaultMaxPinnedPosts,
+		DuplicateWindow:        DefaultDuplicateWindow,
+		DuplicateFlagThreshold: DefaultDuplicateFlags,
 	}
 }
 
@@ -30,6 +33,11 @@ func (p Params) Validate() error {
 	//     return errors.New("SomeValue cannot be nil")
 	// }
 
-	// Return nil for now as the current param is just a placeholder
+	if p.MaxPinnedPosts > MaxPinnedPostsLimit {
+		return WrapErrorf(ErrInvalidParameter, "max pinned posts cannot exceed %d", MaxPinnedPostsLimit)
+	}
+	if p.DuplicateWindow < 0 {
+		return WrapError(ErrInvalidParameter, "duplicate window cannot be negative")
+	}
 	return nil
 }
@@ -0,0 +1,6 @@
+package types
+
+// IsRestricted reports whether the post is hidden from anyone outside its audience
+func (p Post) IsRestricted() bool {
+	return p.Audience != PostAudience_POST_AUDIENCE_PUBLIC
+}
@@ -22,6 +22,9 @@ var (
 	ErrVotingNotStarted    = errorsmod.Register(ModuleName, 1110, "voting has not started yet")
 	ErrVotingEnded         = errorsmod.Register(ModuleName, 1111, "voting has ended")
 	ErrAlreadyVoted        = errorsmod.Register(ModuleName, 1112, "already voted")
+	ErrAlreadyReposted     = errorsmod.Register(ModuleName, 1113, "user has already reposted this post")
+	ErrAlreadyReported     = errorsmod.Register(ModuleName, 1114, "user has already reported this post")
+	ErrDuplicateContent    = errorsmod.Register(ModuleName, 1115, "duplicate content")
 )
 
 // Additional error types for better coverage
@@ -48,6 +51,7 @@ var (
 	ErrOperationTimeout      = errorsmod.Register(ModuleName, 1219, "operation timeout")
 	ErrResourceLimitExceeded = errorsmod.Register(ModuleName, 1220, "resource limit exceeded")
 	ErrResourceNotFound      = errorsmod.Register(ModuleName, 1221, "resource not found")
+	ErrInvalidGenesis        = errorsmod.Register(ModuleName, 1222, "invalid genesis state")
 )
 
 // Error helper functions
@@ -146,7 +150,7 @@ func ToGRPCError(err error) error {
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errorsmod.IsOf(err, ErrUnauthorized, ErrPermissionDenied):
 		return status.Error(codes.PermissionDenied, err.Error())
-	case errorsmod.IsOf(err, ErrAlreadyLiked, ErrAlreadySaved, ErrAlreadyVoted):
+	case errorsmod.IsOf(err, ErrAlreadyLiked, ErrAlreadySaved, ErrAlreadyVoted, ErrAlreadyReported, ErrDuplicateContent):
 		return status.Error(codes.AlreadyExists, err.Error())
 	case errorsmod.IsOf(err, ErrResourceLimitExceeded, ErrContentTooLong, ErrTooManyMentions, ErrTooManyTopics, ErrTooManyImages):
 		return status.Error(codes.ResourceExhausted, err.Error())
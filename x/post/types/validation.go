@@ -3,6 +3,8 @@ package types
 import (
 	"strings"
 	"unicode/utf8"
+
+	profiletypes "github.com/rollchains/tlock/x/profile/types"
 )
 
 // ValidatePostContent validates that the content does not exceed the maximum allowed length
@@ -156,3 +158,69 @@ func ValidateTopic(topic string) error {
 	}
 	return nil
 }
+
+// ValidatePoll validates the settings of a new poll
+func ValidatePoll(poll *Poll) error {
+	if len(poll.Vote) < 2 {
+		return NewInvalidRequestError("poll needs at least two options")
+	}
+	if poll.VotingEnd <= poll.VotingStart {
+		return NewInvalidRequestError("poll voting end must be after voting start")
+	}
+	if poll.MaxSelections > uint64(len(poll.Vote)) {
+		return NewInvalidRequestErrorf("max selections %d exceeds the %d poll options", poll.MaxSelections, len(poll.Vote))
+	}
+	if poll.TotalVotes != 0 || poll.Finalized {
+		return NewInvalidRequestError("new poll cannot carry votes or a result")
+	}
+	optionIds := make(map[int64]bool, len(poll.Vote))
+	for _, option := range poll.Vote {
+		if optionIds[option.Id] {
+			return NewInvalidRequestErrorf("duplicate poll option id %d", option.Id)
+		}
+		if option.Count != 0 {
+			return NewInvalidRequestError("new poll cannot carry votes or a result")
+		}
+		optionIds[option.Id] = true
+	}
+	return nil
+}
+
+// ValidateLabels validates that every label is a known content label and is set once
+func ValidateLabels(labels []string) error {
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		if !profiletypes.IsContentLabel(label) {
+			return NewInvalidRequestErrorf("unknown content label %q", label)
+		}
+		if seen[label] {
+			return NewInvalidRequestErrorf("duplicate content label %q", label)
+		}
+		seen[label] = true
+	}
+	return nil
+}
+
+// NormalizeContent folds case and whitespace so that near duplicates of a post
+// share one content hash
+func NormalizeContent(title string, content string) string {
+	return strings.ToLower(strings.Join(strings.Fields(title+" "+content), " "))
+}
+
+// ValidateAudience validates that a close friends post names its list and
+// that no other audience does
+func ValidateAudience(audience PostAudience, listId uint64) error {
+	switch audience {
+	case PostAudience_POST_AUDIENCE_PUBLIC, PostAudience_POST_AUDIENCE_FOLLOWERS:
+		if listId != 0 {
+			return NewInvalidRequestErrorf("audience %s cannot name a list", audience)
+		}
+	case PostAudience_POST_AUDIENCE_CLOSE_FRIENDS:
+		if listId == 0 {
+			return NewInvalidRequestError("close friends audience needs a list id")
+		}
+	default:
+		return NewInvalidRequestErrorf("unknown audience %d", audience)
+	}
+	return nil
+}
@@ -61,6 +61,161 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "QueryPermissions",
+					Use:       "permissions [address]",
+					Short:     "Get the role and permissions of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "address",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryPermissionHolders",
+					Use:       "permission-holders [permission]",
+					Short:     "List the addresses holding a permission",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "permission",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryAuditLog",
+					Use:       "audit-log [page] [limit]",
+					Short:     "List the privileged actions, optionally filtered by --actor and --action",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "page",
+						},
+						{
+							ProtoField: "limit",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryFollowersYouKnow",
+					Use:       "followers-you-know [viewer] [address] [page] [limit]",
+					Short:     "List the followers of an address that the viewer follows",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "viewer",
+						},
+						{
+							ProtoField: "address",
+						},
+						{
+							ProtoField: "page",
+						},
+						{
+							ProtoField: "limit",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryMutualFollowers",
+					Use:       "mutual-followers [address_a] [address_b] [page] [limit]",
+					Short:     "List the accounts following both addresses",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "address_a",
+						},
+						{
+							ProtoField: "address_b",
+						},
+						{
+							ProtoField: "page",
+						},
+						{
+							ProtoField: "limit",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryFollowRequests",
+					Use:       "follow-requests [address] [page] [limit]",
+					Short:     "List the pending follow requests sent to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "address",
+						},
+						{
+							ProtoField: "page",
+						},
+						{
+							ProtoField: "limit",
+						},
+					},
+				},
+				{
+					RpcMethod: "QuerySentFollowRequests",
+					Use:       "sent-follow-requests [address] [page] [limit]",
+					Short:     "List the pending follow requests sent by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "address",
+						},
+						{
+							ProtoField: "page",
+						},
+						{
+							ProtoField: "limit",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryLists",
+					Use:       "lists [owner]",
+					Short:     "List the user lists of an owner, private lists need --viewer to be the owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "owner",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryListMembers",
+					Use:       "list-members [list_id] [page] [limit]",
+					Short:     "List the accounts of a user list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "list_id",
+						},
+						{
+							ProtoField: "page",
+						},
+						{
+							ProtoField: "limit",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryMutes",
+					Use:       "mutes [address]",
+					Short:     "Get the accounts, topics and keywords muted by a user",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "address",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueryBlockedUsers",
+					Use:       "blocked-users [address] [page] [limit]",
+					Short:     "Get list of blocked users",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "address",
+						},
+						{
+							ProtoField: "page",
+						},
+						{
+							ProtoField: "limit",
+						},
+					},
+				},
 				{
 					RpcMethod: "QueryFollowers",
 					Use:       "followers [address]",
@@ -253,6 +408,196 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "SetContentFilter",
+					Use:       "set-content-filter [creator] [excluded_labels]",
+					Short:     "Set the content labels hidden from your feeds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "excluded_labels",
+							Varargs:    true,
+						},
+					},
+				},
+				{
+					RpcMethod: "BlockUser",
+					Use:       "block-user [creator] [target_addr]",
+					Short:     "Block a user",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "target_addr",
+						},
+					},
+				},
+				{
+					RpcMethod: "UnblockUser",
+					Use:       "unblock-user [creator] [target_addr]",
+					Short:     "Unblock a user",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "target_addr",
+						},
+					},
+				},
+				{
+					RpcMethod: "Mute",
+					Use:       "mute [creator] [mute_type] [value]",
+					Short:     "Mute an account, a topic hash or a keyword",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "mute_type",
+						},
+						{
+							ProtoField: "value",
+						},
+					},
+				},
+				{
+					RpcMethod: "Unmute",
+					Use:       "unmute [creator] [mute_type] [value]",
+					Short:     "Unmute an account, a topic hash or a keyword",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "mute_type",
+						},
+						{
+							ProtoField: "value",
+						},
+					},
+				},
+				{
+					RpcMethod: "SetPrivate",
+					Use:       "set-private [creator] [private]",
+					Short:     "Make an account private or public",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "private",
+						},
+					},
+				},
+				{
+					RpcMethod: "ApproveFollow",
+					Use:       "approve-follow [creator] [requester]",
+					Short:     "Approve a follow request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "requester",
+						},
+					},
+				},
+				{
+					RpcMethod: "RejectFollow",
+					Use:       "reject-follow [creator] [requester]",
+					Short:     "Reject a follow request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "requester",
+						},
+					},
+				},
+				{
+					RpcMethod: "CreateList",
+					Use:       "create-list [creator] [name] [private]",
+					Short:     "Create a curated user list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "name",
+						},
+						{
+							ProtoField: "private",
+						},
+					},
+				},
+				{
+					RpcMethod: "RenameList",
+					Use:       "rename-list [creator] [list_id] [name]",
+					Short:     "Rename a user list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "list_id",
+						},
+						{
+							ProtoField: "name",
+						},
+					},
+				},
+				{
+					RpcMethod: "DeleteList",
+					Use:       "delete-list [creator] [list_id]",
+					Short:     "Delete a user list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "list_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "AddListMembers",
+					Use:       "add-list-members [creator] [list_id] [members]",
+					Short:     "Add accounts to a user list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "list_id",
+						},
+						{
+							ProtoField: "members",
+							Varargs:    true,
+						},
+					},
+				},
+				{
+					RpcMethod: "RemoveListMembers",
+					Use:       "remove-list-members [creator] [list_id] [members]",
+					Short:     "Remove accounts from a user list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "creator",
+						},
+						{
+							ProtoField: "list_id",
+						},
+						{
+							ProtoField: "members",
+							Varargs:    true,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/tlock/x/profile/types"
+)
+
+// EndBlocker removes the rate limit counters whose window has ended.
+func (k Keeper) EndBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.PruneRateLimitCounters(ctx, ctx.BlockTime().Unix(), types.MaxRateLimitPrunesPerBlock)
+	return nil
+}
@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/tlock/x/profile/types"
+)
+
+// atTime returns a context whose block time is the given unix time, so that the
+// setters keyed by block time can rebuild the keys of exported records.
+func atTime(ctx sdk.Context, unix int64) sdk.Context {
+	return ctx.WithBlockTime(time.Unix(unix, 0))
+}
+
+// iterateStore walks every key under keyPrefix in ascending order.
+func (k Keeper) iterateStore(ctx sdk.Context, keyPrefix string, cb func(key, value []byte)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Key(), iterator.Value())
+	}
+}
+
+// sortedKeys keeps the counter writes of importState deterministic.
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// importState writes the records of a genesis state and rebuilds the follow
+// lists, following search entries and counters derived from them.
+func (k Keeper) importState(ctx sdk.Context, data *types.GenesisState) error {
+	profiles := make(map[string]types.Profile, len(data.Profiles))
+	for _, profile := range data.Profiles {
+		k.SetProfile(ctx, profile)
+		profiles[profile.WalletAddress] = profile
+	}
+	for _, avatar := range data.Avatars {
+		k.SetProfileAvatar(ctx, avatar.Address, avatar.Avatar)
+	}
+	for _, handle := range data.UserHandles {
+		k.AddToUserHandleList(ctx, handle.UserHandle, handle.Address)
+	}
+	for _, entry := range data.UserSearch {
+		k.AddToUserSearchList(ctx, entry.Keyword, entry.User)
+	}
+
+	for _, follow := range data.Follows {
+		followCtx := atTime(ctx, follow.Timestamp)
+		k.AddToFollowing(followCtx, follow.Follower, follow.Target)
+		k.AddToFollowers(followCtx, follow.Target, follow.Follower)
+		k.SetFollowTime(followCtx, follow.Follower, follow.Target)
+		k.AddToFollowingSearch(ctx, follow.Follower, profiles[follow.Target])
+	}
+
+	for _, block := range data.Blocks {
+		k.BlockUser(atTime(ctx, block.Timestamp), block.Blocker, block.Blocked)
+	}
+
+	for _, request := range data.FollowRequests {
+		k.SetFollowRequest(atTime(ctx, request.Timestamp), request.Requester, request.Target)
+	}
+
+	// user list member counts follow the imported members
+	memberCount := make(map[uint64]uint64)
+	for _, member := range data.ListMembers {
+		k.SetListMember(ctx, member.ListId, member.Address)
+		memberCount[member.ListId]++
+	}
+	var lastListId uint64
+	for _, list := range data.Lists {
+		list.MemberCount = memberCount[list.Id]
+		k.SetUserList(ctx, list)
+		if list.Id > lastListId {
+			lastListId = list.Id
+		}
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.UserListCountKey), itob(int64(lastListId)))
+
+	for _, mute := range data.Mutes {
+		k.SetMute(ctx, mute.Address, mute.MuteType, mute.Value)
+	}
+
+	// the audit log continues after the highest imported id
+	var nextAuditId uint64
+	for _, entry := range data.AuditLog {
+		k.SetAuditLogEntry(ctx, entry)
+		if entry.Id >= nextAuditId {
+			nextAuditId = entry.Id + 1
+		}
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.AuditLogCountKey), itob(int64(nextAuditId)))
+
+	for _, address := range data.Admins {
+		if err := k.AddAdmin(ctx, address); err != nil {
+			return err
+		}
+	}
+	for _, address := range data.EditableAdmins {
+		if err := k.AddEditableAdmin(ctx, address); err != nil {
+			return err
+		}
+	}
+
+	activitiesCount := make(map[string]int64)
+	for _, entry := range data.Activities {
+		k.SetActivitiesReceived(atTime(ctx, entry.Activity.Timestamp), entry.Activity, entry.Address, entry.Operator)
+		activitiesCount[entry.Address]++
+	}
+	for _, address := range sortedKeys(activitiesCount) {
+		k.SetActivitiesReceivedCount(ctx, address, activitiesCount[address])
+	}
+
+	messageCount := make(map[string]int64)
+	for _, message := range data.Messages {
+		k.StoreMessage(atTime(ctx, message.Timestamp), message.Receiver, message.Sender, message.TxHash)
+		messageCount[message.Receiver+"/"+message.Sender]++
+	}
+	for _, pair := range sortedKeys(messageCount) {
+		receiver, sender, _ := strings.Cut(pair, "/")
+		k.SetMessageCount(ctx, receiver, sender, messageCount[pair])
+	}
+	return nil
+}
+
+// exportState reads every profile module record into the genesis state.
+func (k Keeper) exportState(ctx sdk.Context, genesis *types.GenesisState) {
+	k.iterateStore(ctx, types.ProfileKeyPrefix, func(key, value []byte) {
+		var profile types.Profile
+		if err := k.cdc.Unmarshal(value, &profile); err != nil {
+			types.LogError(k.logger, "export_profile", err, "address", string(key))
+			return
+		}
+		genesis.Profiles = append(genesis.Profiles, profile)
+	})
+	k.iterateStore(ctx, types.ProfileAvatarPrefix, func(key, value []byte) {
+		genesis.Avatars = append(genesis.Avatars, types.GenesisAvatar{Address: string(key), Avatar: string(value)})
+	})
+	k.iterateStore(ctx, types.ProfileUserHandleKeyPrefix, func(key, value []byte) {
+		genesis.UserHandles = append(genesis.UserHandles, types.GenesisUserHandle{UserHandle: string(key), Address: string(value)})
+	})
+	k.iterateStore(ctx, types.ProfileUserSearchKeyPrefix, func(key, value []byte) {
+		var user types.UserSearch
+		if err := k.cdc.Unmarshal(value, &user); err != nil {
+			types.LogError(k.logger, "export_user_search", err, "key", string(key))
+			return
+		}
+		// the key is the padded keyword, ":" and the wallet address
+		keyword := strings.TrimSuffix(string(key), ":"+user.WalletAddress)
+		keyword = strings.TrimRight(keyword, string(rune(types.UserSearchPaddingChar)))
+		genesis.UserSearch = append(genesis.UserSearch, types.GenesisUserSearch{Keyword: keyword, User: user})
+	})
+
+	k.iterateStore(ctx, types.ProfileFollowTimePrefix, func(key, value []byte) {
+		follower, target, ok := strings.Cut(string(key), ":")
+		if ok {
+			genesis.Follows = append(genesis.Follows, types.GenesisFollow{Follower: follower, Target: target, Timestamp: btoi(value)})
+		}
+	})
+
+	k.iterateStore(ctx, types.ProfileBlockTimePrefix, func(key, value []byte) {
+		blocker, blocked, ok := strings.Cut(string(key), ":")
+		if ok {
+			genesis.Blocks = append(genesis.Blocks, types.GenesisBlock{Blocker: blocker, Blocked: blocked, Timestamp: btoi(value)})
+		}
+	})
+
+	k.iterateStore(ctx, types.ProfileFollowRequestTimePrefix, func(key, value []byte) {
+		requester, target, ok := strings.Cut(string(key), ":")
+		if ok {
+			genesis.FollowRequests = append(genesis.FollowRequests, types.FollowRequest{Requester: requester, Target: target, Timestamp: btoi(value)})
+		}
+	})
+
+	k.iterateStore(ctx, types.UserListKeyPrefix, func(key, value []byte) {
+		var list types.UserList
+		if err := k.cdc.Unmarshal(value, &list); err != nil {
+			types.LogError(k.logger, "export_user_list", err, "id", btoi(key))
+			return
+		}
+		genesis.Lists = append(genesis.Lists, list)
+		for _, member := range k.GetListMembers(ctx, list.Id) {
+			genesis.ListMembers = append(genesis.ListMembers, types.GenesisListMember{ListId: list.Id, Address: member})
+		}
+	})
+
+	// mutes are keyed by address/type/value
+	k.iterateStore(ctx, types.ProfileMutePrefix, func(key, value []byte) {
+		parts := strings.SplitN(string(key), "/", 3)
+		if len(parts) != 3 {
+			return
+		}
+		muteType, err := strconv.ParseInt(parts[1], 10, 32)
+		if err != nil {
+			return
+		}
+		genesis.Mutes = append(genesis.Mutes, types.GenesisMute{Address: parts[0], MuteType: types.MuteType(muteType), Value: string(value)})
+	})
+
+	k.iterateStore(ctx, types.AuditLogKeyPrefix, func(key, value []byte) {
+		var entry types.AuditLogEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			types.LogError(k.logger, "export_audit_log", err, "id", btoi(key))
+			return
+		}
+		genesis.AuditLog = append(genesis.AuditLog, entry)
+	})
+
+	k.iterateStore(ctx, types.AuthorityKeyPrefix, func(key, _ []byte) {
+		genesis.Admins = append(genesis.Admins, string(key))
+	})
+	k.iterateStore(ctx, types.AuthorityEditableAdminKeyPrefix, func(key, _ []byte) {
+		genesis.EditableAdmins = append(genesis.EditableAdmins, string(key))
+	})
+
+	// activities are keyed by address/blockTime/type/operator, the counters
+	// share the prefix under count/
+	k.iterateStore(ctx, types.ActivitiesReceivedPrefix, func(key, value []byte) {
+		if bytes.HasPrefix(key, []byte("count/")) {
+			return
+		}
+		address, rest, ok := bytes.Cut(key, []byte("/"))
+		if !ok || len(rest) < 8 {
+			return
+		}
+		var activity types.ActivitiesReceived
+		if err := k.cdc.Unmarshal(value, &activity); err != nil {
+			types.LogError(k.logger, "export_activities_received", err, "address", string(address))
+			return
+		}
+		operator := strings.TrimPrefix(string(rest[8:]), activity.ActivitiesType.String())
+		genesis.Activities = append(genesis.Activities, types.GenesisActivity{
+			Address:  string(address),
+			Operator: operator,
+			Activity: activity,
+		})
+	})
+
+	// messages are keyed by receiver/sender/blockTime, the counters share the
+	// prefix under count/
+	k.iterateStore(ctx, types.ProfileMessagePrefix, func(key, value []byte) {
+		if bytes.HasPrefix(key, []byte("count/")) {
+			return
+		}
+		parts := bytes.SplitN(key, []byte("/"), 3)
+		if len(parts) != 3 || len(parts[2]) != 8 {
+			return
+		}
+		genesis.Messages = append(genesis.Messages, types.GenesisMessage{
+			Receiver:  string(parts[0]),
+			Sender:    string(parts[1]),
+			Timestamp: btoi(parts[2]),
+			TxHash:    string(value),
+		})
+	})
+}
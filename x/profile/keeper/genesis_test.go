@@ -20,3 +20,65 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := SetupTest(t)
+	alice := f.addrs[0].String()
+	bob := f.addrs[1].String()
+
+	genesisState := &types.GenesisState{
+		Params: types.DefaultParams(),
+		Profiles: []types.Profile{
+			{WalletAddress: alice, UserHandle: "alice", Nickname: "Alice", Followers: 1, CreationTime: 100},
+			{WalletAddress: bob, UserHandle: "bob", Nickname: "Bob", Following: 1, CreationTime: 100},
+		},
+		Avatars: []types.GenesisAvatar{{Address: alice, Avatar: "avatar"}},
+		UserHandles: []types.GenesisUserHandle{
+			{UserHandle: "alice", Address: alice},
+			{UserHandle: "bob", Address: bob},
+		},
+		UserSearch: []types.GenesisUserSearch{
+			{Keyword: "alice", User: types.UserSearch{WalletAddress: alice, UserHandle: "alice", Nickname: "Alice"}},
+		},
+		Follows: []types.GenesisFollow{{Follower: bob, Target: alice, Timestamp: 150}},
+		Admins:  []string{alice},
+		Activities: []types.GenesisActivity{{
+			Address:  alice,
+			Operator: bob,
+			Activity: types.ActivitiesReceived{Address: bob, ActivitiesType: types.ActivitiesType_ACTIVITIES_FOLLOW, Timestamp: 150},
+		}},
+		Messages:    []types.GenesisMessage{{Receiver: alice, Sender: bob, Timestamp: 160, TxHash: "hash"}},
+		Lists:       []types.UserList{{Id: 1, Owner: alice, Name: "devs", MemberCount: 1, CreatedAt: 100}},
+		ListMembers: []types.GenesisListMember{{ListId: 1, Address: bob}},
+	}
+	require.NoError(t, genesisState.Validate())
+	require.NoError(t, f.k.InitGenesis(f.ctx, genesisState))
+
+	// the follow lists and counters are rebuilt
+	require.True(t, f.k.IsFollowing(f.ctx, bob, alice))
+	require.Equal(t, []string{bob}, f.k.GetFollowers(f.ctx, alice))
+	followTime, ok := f.k.GetFollowTime(f.ctx, bob, alice)
+	require.True(t, ok)
+	require.Equal(t, uint64(150), followTime)
+	require.True(t, f.k.IsAdmin(f.ctx, alice))
+	require.Equal(t, int64(1), f.k.GetMessageCount(f.ctx, alice, bob))
+	count, _ := f.k.GetActivitiesReceivedCount(f.ctx, alice)
+	require.Equal(t, int64(1), count)
+	require.True(t, f.k.IsListMember(f.ctx, 1, bob))
+
+	got := f.k.ExportGenesis(f.ctx)
+	require.NoError(t, got.Validate())
+	require.Equal(t, genesisState.Profiles, got.Profiles)
+	require.Equal(t, genesisState.Avatars, got.Avatars)
+	require.Equal(t, genesisState.UserHandles, got.UserHandles)
+	require.Equal(t, genesisState.UserSearch, got.UserSearch)
+	require.Equal(t, genesisState.Follows, got.Follows)
+	require.Equal(t, genesisState.Admins, got.Admins)
+	require.Equal(t, genesisState.Activities, got.Activities)
+	require.Equal(t, genesisState.Messages, got.Messages)
+	require.Equal(t, genesisState.Lists, got.Lists)
+	require.Equal(t, genesisState.ListMembers, got.ListMembers)
+
+	// new lists continue after the imported ones
+	require.Equal(t, uint64(2), f.k.NextUserListId(f.ctx))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"sort"
 	"strings"
 
 	"cosmossdk.io/collections"
@@ -36,6 +37,8 @@ type Keeper struct {
 	authority     string
 	storeKey      kvtypes.StoreKey
 	paramSubspace paramtypes.Subspace
+
+	hooks types.ProfileHooks
 }
 
 // NewKeeper creates a new Keeper instance
@@ -93,6 +96,15 @@ func NewKeeper(
 	return k
 }
 
+// SetHooks sets the profile hooks, it can only be called once.
+func (k *Keeper) SetHooks(hooks types.ProfileHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set profile hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
 func (k Keeper) Logger() log.Logger {
 	return k.logger
 }
@@ -102,7 +114,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
-	return k.Params.Set(ctx, data.Params)
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+	return k.importState(ctx, data)
 }
 
 // ExportGenesis exports the module's state to a genesis state.
@@ -112,9 +127,11 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return &types.GenesisState{
+	genesis := &types.GenesisState{
 		Params: params,
 	}
+	k.exportState(sdk.UnwrapSDKContext(ctx), genesis)
+	return genesis
 }
 
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
@@ -160,6 +177,15 @@ func (k Keeper) SetProfile(ctx sdk.Context, profile types.Profile) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileKeyPrefix))
 	bz := k.cdc.MustMarshal(&profile)
 	store.Set([]byte(profile.WalletAddress), bz)
+
+	// index the profiles with an admin level so the permission holders can be
+	// listed without walking every profile
+	levelStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AuthorityAdminLevelKeyPrefix))
+	if profile.AdminLevel > 0 {
+		levelStore.Set([]byte(profile.WalletAddress), []byte(profile.WalletAddress))
+	} else if levelStore.Has([]byte(profile.WalletAddress)) {
+		levelStore.Delete([]byte(profile.WalletAddress))
+	}
 }
 
 // GetProfile
@@ -379,6 +405,66 @@ func (k Keeper) GetFollowingPagination(ctx sdk.Context, address string, page uin
 	return followings, pageRes, nil
 }
 
+// GetFollowersYouKnow returns a page of the followers of address that viewer
+// follows, walking the shorter of viewer's following and address's followers.
+func (k Keeper) GetFollowersYouKnow(ctx sdk.Context, viewer string, address string, page uint64, limit uint64) ([]string, uint64, bool) {
+	viewerProfile, _ := k.GetProfile(ctx, viewer)
+	profile, _ := k.GetProfile(ctx, address)
+	if viewerProfile.Following <= profile.Followers {
+		return k.scanFollowGraph(ctx, types.ProfileFollowingPrefix+viewer+"/", func(following string) bool {
+			_, found := k.GetFollowTime(ctx, following, address)
+			return found
+		}, page, limit)
+	}
+	return k.scanFollowGraph(ctx, types.ProfileFollowersPrefix+address+"/", func(follower string) bool {
+		_, found := k.GetFollowTime(ctx, viewer, follower)
+		return found
+	}, page, limit)
+}
+
+// GetMutualFollowers returns a page of the accounts following both addressA
+// and addressB, walking the shorter of the two followers lists.
+func (k Keeper) GetMutualFollowers(ctx sdk.Context, addressA string, addressB string, page uint64, limit uint64) ([]string, uint64, bool) {
+	profileA, _ := k.GetProfile(ctx, addressA)
+	profileB, _ := k.GetProfile(ctx, addressB)
+	if profileB.Followers < profileA.Followers {
+		addressA, addressB = addressB, addressA
+	}
+	return k.scanFollowGraph(ctx, types.ProfileFollowersPrefix+addressA+"/", func(follower string) bool {
+		_, found := k.GetFollowTime(ctx, follower, addressB)
+		return found
+	}, page, limit)
+}
+
+// scanFollowGraph walks at most MaxGraphScan entries of a following or
+// followers list, newest first, and returns the page of the addresses
+// accepted by match, their total and whether the walk stopped early.
+func (k Keeper) scanFollowGraph(ctx sdk.Context, keyPrefix string, match func(address string) bool, page uint64, limit uint64) ([]string, uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	first := page * limit
+	var addresses []string
+	var total uint64
+	scanned := 0
+	for ; iterator.Valid(); iterator.Next() {
+		if scanned == types.MaxGraphScan {
+			return addresses, total, true
+		}
+		scanned++
+		address := string(iterator.Value())
+		if !match(address) {
+			continue
+		}
+		if total >= first && uint64(len(addresses)) < limit {
+			addresses = append(addresses, address)
+		}
+		total++
+	}
+	return addresses, total, false
+}
+
 // IsFollowing
 func (k Keeper) IsFollowing(ctx sdk.Context, follower string, target string) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowingPrefix+follower+"/"))
@@ -513,6 +599,412 @@ func (k Keeper) DeleteFollowTime(ctx sdk.Context, followerAddr string, targetAdd
 	store.Delete(key)
 }
 
+// BlockUser adds the target address to the blocker's blocked list
+func (k Keeper) BlockUser(ctx sdk.Context, blockerAddr string, targetAddr string) {
+	blockTime := ctx.BlockTime().Unix()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileBlockedPrefix+blockerAddr+"/"))
+	store.Set(append(itob(blockTime), []byte(targetAddr)...), []byte(targetAddr))
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileBlockTimePrefix))
+	timeStore.Set([]byte(fmt.Sprintf("%s:%s", blockerAddr, targetAddr)), itob(blockTime))
+}
+
+// UnblockUser removes the target address from the blocker's blocked list
+func (k Keeper) UnblockUser(ctx sdk.Context, blockerAddr string, targetAddr string) {
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileBlockTimePrefix))
+	timeKey := []byte(fmt.Sprintf("%s:%s", blockerAddr, targetAddr))
+	bz := timeStore.Get(timeKey)
+	if bz == nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileBlockedPrefix+blockerAddr+"/"))
+	store.Delete(append(bz, []byte(targetAddr)...))
+	timeStore.Delete(timeKey)
+}
+
+// IsBlocked reports whether blocker has blocked target
+func (k Keeper) IsBlocked(ctx sdk.Context, blockerAddr string, targetAddr string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileBlockTimePrefix))
+	return store.Has([]byte(fmt.Sprintf("%s:%s", blockerAddr, targetAddr)))
+}
+
+// SetFollowRequest records a pending follow of requester to the private target
+func (k Keeper) SetFollowRequest(ctx sdk.Context, requester string, target string) {
+	blockTime := ctx.BlockTime().Unix()
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowRequestPrefix+target+"/"))
+	store.Set(append(itob(blockTime), []byte(requester)...), []byte(requester))
+
+	sentStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowRequestSentPrefix+requester+"/"))
+	sentStore.Set(append(itob(blockTime), []byte(target)...), []byte(target))
+
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowRequestTimePrefix))
+	timeStore.Set([]byte(fmt.Sprintf("%s:%s", requester, target)), itob(blockTime))
+}
+
+// DeleteFollowRequest removes the pending follow of requester to target, it
+// reports whether there was one
+func (k Keeper) DeleteFollowRequest(ctx sdk.Context, requester string, target string) bool {
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowRequestTimePrefix))
+	timeKey := []byte(fmt.Sprintf("%s:%s", requester, target))
+	bz := timeStore.Get(timeKey)
+	if bz == nil {
+		return false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowRequestPrefix+target+"/"))
+	store.Delete(append(bz, []byte(requester)...))
+	sentStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowRequestSentPrefix+requester+"/"))
+	sentStore.Delete(append(bz, []byte(target)...))
+	timeStore.Delete(timeKey)
+	return true
+}
+
+// HasFollowRequest reports whether requester waits for target to approve a follow
+func (k Keeper) HasFollowRequest(ctx sdk.Context, requester string, target string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowRequestTimePrefix))
+	return store.Has([]byte(fmt.Sprintf("%s:%s", requester, target)))
+}
+
+// GetFollowRequestsPagination returns a page of the follow requests sent to
+// address, or sent by address when sent is true, newest first
+func (k Keeper) GetFollowRequestsPagination(ctx sdk.Context, address string, sent bool, page uint64, limit uint64) ([]types.FollowRequest, *query.PageResponse, error) {
+	keyPrefix := types.ProfileFollowRequestPrefix
+	if sent {
+		keyPrefix = types.ProfileFollowRequestSentPrefix
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix+address+"/"))
+	pagination := &query.PageRequest{
+		Limit:      limit,
+		Offset:     page * limit,
+		Reverse:    true,
+		CountTotal: true,
+	}
+	var requests []types.FollowRequest
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		request := types.FollowRequest{Requester: string(value), Target: address, Timestamp: btoi(key[:8])}
+		if sent {
+			request.Requester, request.Target = address, string(value)
+		}
+		requests = append(requests, request)
+		return nil
+	})
+
+	if err != nil {
+		types.LogError(k.logger, "get_follow_requests_pagination", types.ErrDatabaseOperation, "address", address, "page", page, "limit", limit)
+		return nil, nil, types.WrapError(types.ErrDatabaseOperation, "failed to paginate follow requests")
+	}
+	return requests, pageRes, nil
+}
+
+// GetFollowRequesters returns every address waiting for target to approve a follow
+func (k Keeper) GetFollowRequesters(ctx sdk.Context, target string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileFollowRequestPrefix+target+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	var requesters []string
+	for ; iterator.Valid(); iterator.Next() {
+		requesters = append(requesters, string(iterator.Value()))
+	}
+	return requesters
+}
+
+// IsPrivate reports whether address is a private account
+func (k Keeper) IsPrivate(ctx sdk.Context, address string) bool {
+	profile, found := k.GetProfile(ctx, address)
+	return found && profile.Private
+}
+
+// CanViewPosts reports whether viewer may see the posts of creator, the posts
+// of a private account are only visible to itself and its followers
+func (k Keeper) CanViewPosts(ctx sdk.Context, viewer string, creator string) bool {
+	if viewer == creator || !k.IsPrivate(ctx, creator) {
+		return true
+	}
+	if viewer == "" {
+		return false
+	}
+	_, following := k.GetFollowTime(ctx, viewer, creator)
+	return following
+}
+
+// GetMaxListMembers returns how many accounts a user list can hold
+func (k Keeper) GetMaxListMembers(ctx sdk.Context) uint64 {
+	if max := k.GetParams(ctx).MaxListMembers; max > 0 {
+		return max
+	}
+	return types.DefaultMaxListMembers
+}
+
+// NextUserListId returns the id of the next user list and advances the counter
+func (k Keeper) NextUserListId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(btoi(store.Get([]byte(types.UserListCountKey)))) + 1
+	store.Set([]byte(types.UserListCountKey), itob(int64(id)))
+	return id
+}
+
+func userListOwnerKeyPrefix(owner string) string {
+	return types.UserListOwnerKeyPrefix + owner + "/"
+}
+
+func userListMemberKeyPrefix(id uint64) string {
+	return fmt.Sprintf("%s%d/", types.UserListMemberKeyPrefix, id)
+}
+
+// SetUserList stores a user list and indexes it under its owner
+func (k Keeper) SetUserList(ctx sdk.Context, list types.UserList) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserListKeyPrefix))
+	store.Set(itob(int64(list.Id)), k.cdc.MustMarshal(&list))
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(userListOwnerKeyPrefix(list.Owner)))
+	ownerStore.Set(itob(int64(list.Id)), []byte{})
+}
+
+// GetUserList returns the user list with the given id
+func (k Keeper) GetUserList(ctx sdk.Context, id uint64) (types.UserList, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserListKeyPrefix))
+	var list types.UserList
+	bz := store.Get(itob(int64(id)))
+	if bz == nil {
+		return list, false
+	}
+	k.cdc.MustUnmarshal(bz, &list)
+	return list, true
+}
+
+// DeleteUserList removes a user list with its owner index and members
+func (k Keeper) DeleteUserList(ctx sdk.Context, list types.UserList) {
+	for _, member := range k.GetListMembers(ctx, list.Id) {
+		k.DeleteListMember(ctx, list.Id, member)
+	}
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(userListOwnerKeyPrefix(list.Owner)))
+	ownerStore.Delete(itob(int64(list.Id)))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.UserListKeyPrefix))
+	store.Delete(itob(int64(list.Id)))
+}
+
+// GetUserListsByOwner returns the user lists of owner, oldest first
+func (k Keeper) GetUserListsByOwner(ctx sdk.Context, owner string) []types.UserList {
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(userListOwnerKeyPrefix(owner)))
+	iterator := ownerStore.Iterator(nil, nil)
+	defer iterator.Close()
+	var lists []types.UserList
+	for ; iterator.Valid(); iterator.Next() {
+		if list, found := k.GetUserList(ctx, uint64(btoi(iterator.Key()))); found {
+			lists = append(lists, list)
+		}
+	}
+	return lists
+}
+
+// SetListMember adds address to the members of a user list
+func (k Keeper) SetListMember(ctx sdk.Context, listId uint64, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(userListMemberKeyPrefix(listId)))
+	store.Set([]byte(address), []byte(address))
+}
+
+// DeleteListMember removes address from the members of a user list
+func (k Keeper) DeleteListMember(ctx sdk.Context, listId uint64, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(userListMemberKeyPrefix(listId)))
+	store.Delete([]byte(address))
+}
+
+// IsListMember reports whether address is a member of a user list
+func (k Keeper) IsListMember(ctx sdk.Context, listId uint64, address string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(userListMemberKeyPrefix(listId)))
+	return store.Has([]byte(address))
+}
+
+// GetListMembers returns every member of a user list ordered by address
+func (k Keeper) GetListMembers(ctx sdk.Context, listId uint64) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(userListMemberKeyPrefix(listId)))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	var members []string
+	for ; iterator.Valid(); iterator.Next() {
+		members = append(members, string(iterator.Value()))
+	}
+	return members
+}
+
+// GetListMembersPagination returns a page of the members of a user list
+// ordered by address
+func (k Keeper) GetListMembersPagination(ctx sdk.Context, listId uint64, page uint64, limit uint64) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(userListMemberKeyPrefix(listId)))
+	pagination := &query.PageRequest{
+		Limit:      limit,
+		Offset:     page * limit,
+		CountTotal: true,
+	}
+	var members []string
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		members = append(members, string(value))
+		return nil
+	})
+
+	if err != nil {
+		types.LogError(k.logger, "get_list_members_pagination", types.ErrDatabaseOperation, "list_id", listId, "page", page, "limit", limit)
+		return nil, nil, types.WrapError(types.ErrDatabaseOperation, "failed to paginate list members")
+	}
+	return members, pageRes, nil
+}
+
+func muteKeyPrefix(address string, muteType types.MuteType) string {
+	return fmt.Sprintf("%s%s/%d/", types.ProfileMutePrefix, address, muteType)
+}
+
+// SetMute adds a mute rule of the given type to address
+func (k Keeper) SetMute(ctx sdk.Context, address string, muteType types.MuteType, value string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(muteKeyPrefix(address, muteType)))
+	store.Set([]byte(value), []byte(value))
+}
+
+// DeleteMute removes a mute rule of the given type from address
+func (k Keeper) DeleteMute(ctx sdk.Context, address string, muteType types.MuteType, value string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(muteKeyPrefix(address, muteType)))
+	store.Delete([]byte(value))
+}
+
+// HasMute reports whether address has muted value
+func (k Keeper) HasMute(ctx sdk.Context, address string, muteType types.MuteType, value string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(muteKeyPrefix(address, muteType)))
+	return store.Has([]byte(value))
+}
+
+// GetMuteValues returns the values address has muted of the given type
+func (k Keeper) GetMuteValues(ctx sdk.Context, address string, muteType types.MuteType) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(muteKeyPrefix(address, muteType)))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var values []string
+	for ; iterator.Valid(); iterator.Next() {
+		values = append(values, string(iterator.Value()))
+	}
+	return values
+}
+
+// GetMutes returns every mute rule of address
+func (k Keeper) GetMutes(ctx sdk.Context, address string) types.MuteList {
+	return types.MuteList{
+		Accounts: k.GetMuteValues(ctx, address, types.MuteType_MUTE_TYPE_ACCOUNT),
+		Topics:   k.GetMuteValues(ctx, address, types.MuteType_MUTE_TYPE_TOPIC),
+		Keywords: k.GetMuteValues(ctx, address, types.MuteType_MUTE_TYPE_KEYWORD),
+	}
+}
+
+// GetRateLimit returns the quota of a message type, found is false when the
+// type is not rate limited
+func (k Keeper) GetRateLimit(ctx sdk.Context, msgType string) (types.RateLimit, bool) {
+	for _, limit := range k.GetParams(ctx).RateLimits {
+		if limit.MsgType == msgType {
+			return limit, true
+		}
+	}
+	return types.RateLimit{}, false
+}
+
+// ConsumeRateLimit counts a message of msgType sent by address and rejects it
+// once the address used up its quota for the block or the hour. Every profile
+// level grows the quotas by RateLimitLevelBonus percent.
+func (k Keeper) ConsumeRateLimit(ctx sdk.Context, address string, msgType string) error {
+	limit, found := k.GetRateLimit(ctx, msgType)
+	if !found || (limit.PerBlock == 0 && limit.PerHour == 0) {
+		return nil
+	}
+	var level uint64
+	if profile, ok := k.GetProfile(ctx, address); ok {
+		level = profile.Level
+	}
+	bonus := k.GetParams(ctx).RateLimitLevelBonus
+	scale := func(quota uint64) uint64 {
+		return quota + quota*level*bonus/100
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitCounterPrefix+address+"/"))
+	var counter types.RateLimitCounter
+	if bz := store.Get([]byte(msgType)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &counter)
+	}
+	if counter.Height != ctx.BlockHeight() {
+		counter.Height = ctx.BlockHeight()
+		counter.BlockCount = 0
+	}
+	newWindow := false
+	if now := ctx.BlockTime().Unix(); now >= counter.WindowStart+types.RateLimitWindow {
+		counter.WindowStart = now
+		counter.WindowCount = 0
+		newWindow = true
+	}
+	if limit.PerBlock > 0 && counter.BlockCount >= scale(limit.PerBlock) {
+		return types.WrapErrorf(types.ErrRateLimited, "%s can send %d %s per block", address, scale(limit.PerBlock), msgType)
+	}
+	if limit.PerHour > 0 && counter.WindowCount >= scale(limit.PerHour) {
+		return types.WrapErrorf(types.ErrRateLimited, "%s can send %d %s per hour", address, scale(limit.PerHour), msgType)
+	}
+	counter.BlockCount++
+	counter.WindowCount++
+	store.Set([]byte(msgType), k.cdc.MustMarshal(&counter))
+	if newWindow {
+		counterKey := address + "/" + msgType
+		queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitQueuePrefix))
+		queueStore.Set(append(itob(counter.WindowStart+types.RateLimitWindow), []byte(counterKey)...), []byte(counterKey))
+	}
+	return nil
+}
+
+// PruneRateLimitCounters drops at most limit rate limit counters whose window
+// ended before now. A counter that started a new window since it was queued is kept.
+func (k Keeper) PruneRateLimitCounters(ctx sdk.Context, now int64, limit int) {
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitQueuePrefix))
+	iterator := queueStore.Iterator(nil, itob(now+1))
+	var queueKeys, counterKeys [][]byte
+	for ; iterator.Valid() && len(queueKeys) < limit; iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+		counterKeys = append(counterKeys, iterator.Value())
+	}
+	iterator.Close()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitCounterPrefix))
+	for i, counterKey := range counterKeys {
+		queueStore.Delete(queueKeys[i])
+		bz := store.Get(counterKey)
+		if bz == nil {
+			continue
+		}
+		var counter types.RateLimitCounter
+		k.cdc.MustUnmarshal(bz, &counter)
+		if counter.WindowStart+types.RateLimitWindow <= now {
+			store.Delete(counterKey)
+		}
+	}
+}
+
+// HasRateLimitCounter reports whether address has a counter for msgType.
+func (k Keeper) HasRateLimitCounter(ctx sdk.Context, address string, msgType string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitCounterPrefix+address+"/"))
+	return store.Has([]byte(msgType))
+}
+
+// GetBlockedPagination returns a page of the users blocked by address, newest first
+func (k Keeper) GetBlockedPagination(ctx sdk.Context, address string, page uint64, limit uint64) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ProfileBlockedPrefix+address+"/"))
+	pagination := &query.PageRequest{
+		Limit:   limit,
+		Offset:  page * limit,
+		Reverse: true,
+	}
+	var blocked []string
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		blocked = append(blocked, string(value))
+		return nil
+	})
+
+	if err != nil {
+		types.LogError(k.logger, "get_blocked_pagination", types.ErrDatabaseOperation, "address", address, "page", page, "limit", limit)
+		return nil, nil, types.WrapError(types.ErrDatabaseOperation, "failed to paginate blocked list")
+	}
+	return blocked, pageRes, nil
+}
+
 func (k Keeper) EncodeBlockTime(ctx sdk.Context) []byte {
 	blockTime := ctx.BlockTime().Unix()
 	bzBlockTime := make([]byte, 8)
@@ -528,6 +1020,35 @@ func (k Keeper) SetActivitiesReceived(ctx sdk.Context, activitiesReceived types.
 	store.Set(key, bz)
 }
 
+// GetActivityReceived returns the activity an operator left for targetAddr at the given time.
+func (k Keeper) GetActivityReceived(ctx sdk.Context, targetAddr string, timestamp int64, activitiesType types.ActivitiesType, operator string) (types.ActivitiesReceived, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ActivitiesReceivedPrefix+targetAddr+"/"))
+	key := append(append(itob(timestamp), []byte(activitiesType.String())...), []byte(operator)...)
+	var activity types.ActivitiesReceived
+	bz := store.Get(key)
+	if bz == nil {
+		return activity, false
+	}
+	if err := k.cdc.Unmarshal(bz, &activity); err != nil {
+		types.LogError(k.logger, "unmarshal_activity_received", err, "address", targetAddr)
+		return activity, false
+	}
+	return activity, true
+}
+
+// DeleteActivityReceived removes a single activity of targetAddr and lowers its activity count.
+func (k Keeper) DeleteActivityReceived(ctx sdk.Context, targetAddr string, timestamp int64, activitiesType types.ActivitiesType, operator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ActivitiesReceivedPrefix+targetAddr+"/"))
+	key := append(append(itob(timestamp), []byte(activitiesType.String())...), []byte(operator)...)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	if count, _ := k.GetActivitiesReceivedCount(ctx, targetAddr); count > 0 {
+		k.SetActivitiesReceivedCount(ctx, targetAddr, count-1)
+	}
+}
+
 func (k Keeper) GetActivitiesReceived(ctx sdk.Context, address string, page uint64) ([]*types.ActivitiesReceived, *query.PageResponse, uint64, error) {
 	if page < 1 {
 		page = 1
@@ -617,6 +1138,141 @@ func (k Keeper) IsAdmin(ctx sdk.Context, address string) bool {
 	return store.Has(key)
 }
 
+// GetRole returns the role of address, the module admin address and the chief
+// moderator are super admins, the other roles follow the admin list, the
+// editable admin list and the profile admin level.
+func (k Keeper) GetRole(ctx sdk.Context, address string) types.Role {
+	if address == "" {
+		return types.Role_ROLE_NONE
+	}
+	params := k.GetParams(ctx)
+	if address == params.AdminAddress || address == params.ChiefModerator {
+		return types.Role_ROLE_SUPER_ADMIN
+	}
+	profile, _ := k.GetProfile(ctx, address)
+	role := types.RoleForAdminLevel(profile.AdminLevel)
+	if role < types.Role_ROLE_ADMIN && k.IsAdmin(ctx, address) {
+		role = types.Role_ROLE_ADMIN
+	}
+	if role < types.Role_ROLE_EDITOR && k.IsEditableAdmin(ctx, address) {
+		role = types.Role_ROLE_EDITOR
+	}
+	return role
+}
+
+// HasPermission reports whether the role of address grants permission
+func (k Keeper) HasPermission(ctx sdk.Context, address string, permission types.Permission) bool {
+	return k.GetRole(ctx, address).HasPermission(permission)
+}
+
+// GetPermissionHolders returns the addresses holding permission in address order
+func (k Keeper) GetPermissionHolders(ctx sdk.Context, permission types.Permission) []types.PermissionHolder {
+	candidates := make(map[string]bool)
+	params := k.GetParams(ctx)
+	candidates[params.AdminAddress] = true
+	candidates[params.ChiefModerator] = true
+	for _, keyPrefix := range []string{types.AuthorityKeyPrefix, types.AuthorityEditableAdminKeyPrefix, types.AuthorityAdminLevelKeyPrefix} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			candidates[string(iterator.Key())] = true
+		}
+		iterator.Close()
+	}
+	delete(candidates, "")
+
+	addresses := make([]string, 0, len(candidates))
+	for address := range candidates {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	var holders []types.PermissionHolder
+	for _, address := range addresses {
+		if role := k.GetRole(ctx, address); role.HasPermission(permission) {
+			holders = append(holders, types.PermissionHolder{Address: address, Role: role})
+		}
+	}
+	return holders
+}
+
+// AppendAuditLog adds an entry for a privileged action to the audit log and
+// returns its id. Entries are never changed or removed.
+func (k Keeper) AppendAuditLog(ctx sdk.Context, actor string, action types.AuditAction, target string, oldValue string, newValue string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(btoi(store.Get([]byte(types.AuditLogCountKey))))
+	k.SetAuditLogEntry(ctx, types.AuditLogEntry{
+		Id:        id,
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		Height:    ctx.BlockHeight(),
+		Timestamp: ctx.BlockTime().Unix(),
+	})
+	store.Set([]byte(types.AuditLogCountKey), itob(int64(id+1)))
+	return id
+}
+
+// SetAuditLogEntry stores an audit log entry with its actor and action indexes.
+func (k Keeper) SetAuditLogEntry(ctx sdk.Context, entry types.AuditLogEntry) {
+	store := ctx.KVStore(k.storeKey)
+	key := itob(int64(entry.Id))
+	prefix.NewStore(store, []byte(types.AuditLogKeyPrefix)).Set(key, k.cdc.MustMarshal(&entry))
+	prefix.NewStore(store, []byte(types.AuditLogActorKeyPrefix+entry.Actor+"/")).Set(key, key)
+	prefix.NewStore(store, []byte(auditLogActionKeyPrefix(entry.Action))).Set(key, key)
+}
+
+func auditLogActionKeyPrefix(action types.AuditAction) string {
+	return fmt.Sprintf("%s%d/", types.AuditLogActionKeyPrefix, action)
+}
+
+// GetAuditLog returns a page of the audit log, newest first. An empty actor or
+// an unspecified action matches every entry. Counting the total walks the whole
+// index, so callers ask for it with countTotal.
+func (k Keeper) GetAuditLog(ctx sdk.Context, actor string, action types.AuditAction, page uint64, limit uint64, countTotal bool) ([]types.AuditLogEntry, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	logStore := prefix.NewStore(store, []byte(types.AuditLogKeyPrefix))
+	indexStore := logStore
+	if actor != "" {
+		indexStore = prefix.NewStore(store, []byte(types.AuditLogActorKeyPrefix+actor+"/"))
+	} else if action != types.AuditAction_AUDIT_ACTION_UNSPECIFIED {
+		indexStore = prefix.NewStore(store, []byte(auditLogActionKeyPrefix(action)))
+	}
+	pagination := &query.PageRequest{
+		Limit:      limit,
+		Offset:     page * limit,
+		Reverse:    true,
+		CountTotal: countTotal,
+	}
+
+	// every index is keyed by the entry id
+	var entries []types.AuditLogEntry
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		bz := logStore.Get(key)
+		if bz == nil {
+			return false, nil
+		}
+		var entry types.AuditLogEntry
+		if err := k.cdc.Unmarshal(bz, &entry); err != nil {
+			return false, err
+		}
+		if action != types.AuditAction_AUDIT_ACTION_UNSPECIFIED && entry.Action != action {
+			return false, nil
+		}
+		if accumulate {
+			entries = append(entries, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		types.LogError(k.logger, "get_audit_log", err, "actor", actor, "action", action, "page", page, "limit", limit)
+		return nil, nil, types.WrapError(types.ErrDatabaseOperation, "failed to paginate audit log")
+	}
+	return entries, pageRes, nil
+}
+
 func (k Keeper) AddEditableAdmin(ctx sdk.Context, address string) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AuthorityEditableAdminKeyPrefix))
 	key := append([]byte(address))
@@ -65,7 +65,7 @@ func SetupTest(t *testing.T) *testFixture {
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
 	f.govModAddr = authtypes.NewModuleAddress(govtypes.ModuleName).String()
-	f.addrs = simtestutil.CreateIncrementalAccounts(3)
+	f.addrs = simtestutil.CreateIncrementalAccounts(4)
 
 	keys := storetypes.NewKVStoreKeys(authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName, minttypes.ModuleName, types.ModuleName)
 	f.ctx = sdk.NewContext(integration.CreateMultiStore(keys, logger), cmtproto.Header{}, false, logger)
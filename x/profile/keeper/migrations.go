@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/tlock/x/profile/types"
+)
+
+// Migrator migrates the profile store between consensus versions.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the given keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params added in version 2 to their defaults and
+// indexes the profiles that already hold an admin level.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	params := k.GetParams(ctx)
+	defaults := types.DefaultParams()
+	params.RateLimits = defaults.RateLimits
+	params.RateLimitLevelBonus = defaults.RateLimitLevelBonus
+	params.MaxListMembers = defaults.MaxListMembers
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	var admins []string
+	k.iterateStore(ctx, types.ProfileKeyPrefix, func(key, value []byte) {
+		var profile types.Profile
+		if err := k.cdc.Unmarshal(value, &profile); err != nil {
+			types.LogError(k.logger, "migrate_profile", err, "address", string(key))
+			return
+		}
+		if profile.AdminLevel > 0 {
+			admins = append(admins, profile.WalletAddress)
+		}
+	})
+	levelStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AuthorityAdminLevelKeyPrefix))
+	for _, address := range admins {
+		levelStore.Set([]byte(address), []byte(address))
+	}
+	return nil
+}
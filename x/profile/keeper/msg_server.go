@@ -10,6 +10,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/rollchains/tlock/x/profile/types"
 	"math/rand"
+	"strconv"
 	"strings"
 )
 
@@ -432,59 +433,91 @@ func (ms msgServer) updateUserSearchData(ctx sdk.Context, address string, oldNic
 // Follow implements types.MsgServer.
 func (ms msgServer) Follow(ctx context.Context, msg *types.MsgFollowRequest) (*types.MsgFollowResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	blockTime := sdkCtx.BlockTime().Unix()
 	follower := msg.Creator
 	targetAddr := msg.TargetAddr
 	if follower == targetAddr {
 		return nil, errors.Wrap(types.ErrCannotFollowSelf, "You cannot follow yourself")
 	}
-	isFollowing := ms.k.IsFollowing(sdkCtx, follower, targetAddr)
-	if !isFollowing {
-		ms.k.AddToFollowing(sdkCtx, follower, targetAddr)
-		ms.k.AddToFollowers(sdkCtx, targetAddr, follower)
+	if ms.k.IsBlocked(sdkCtx, targetAddr, follower) || ms.k.IsBlocked(sdkCtx, follower, targetAddr) {
+		return nil, errors.Wrapf(types.ErrUserBlocked, "%s and %s have a block between them", follower, targetAddr)
+	}
+	if ms.k.IsFollowing(sdkCtx, follower, targetAddr) {
+		return &types.MsgFollowResponse{}, nil
+	}
 
-		profileFollower, _ := ms.k.GetProfile(sdkCtx, follower)
-		following := profileFollower.Following
-		following += 1
-		profileFollower.Following = following
-		ms.k.SetProfile(sdkCtx, profileFollower)
+	// a private account approves its followers
+	if ms.k.IsPrivate(sdkCtx, targetAddr) {
+		if !ms.k.HasFollowRequest(sdkCtx, follower, targetAddr) {
+			ms.k.SetFollowRequest(sdkCtx, follower, targetAddr)
+			ms.addActivity(sdkCtx, follower, targetAddr, types.ActivitiesType_ACTIVITIES_FOLLOW_REQUEST)
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeFollowRequest,
+				sdk.NewAttribute(types.AttributeKeyCreator, follower),
+				sdk.NewAttribute(types.AttributeKeyTarget, targetAddr),
+			))
+		}
+		return &types.MsgFollowResponse{Pending: true}, nil
+	}
+	ms.follow(sdkCtx, follower, targetAddr)
 
-		profileTarget, _ := ms.k.GetProfile(sdkCtx, targetAddr)
-		followers := profileTarget.Followers
-		followers += 1
-		profileTarget.Followers = followers
-		ms.k.SetProfile(sdkCtx, profileTarget)
+	return &types.MsgFollowResponse{}, nil
+}
 
-		ms.k.AddToFollowingSearch(sdkCtx, follower, profileTarget)
+// follow adds the follow from follower to targetAddr and notifies the target.
+func (ms msgServer) follow(sdkCtx sdk.Context, follower string, targetAddr string) {
+	ms.k.AddToFollowing(sdkCtx, follower, targetAddr)
+	ms.k.AddToFollowers(sdkCtx, targetAddr, follower)
 
-		activitiesReceived := types.ActivitiesReceived{
-			Address:        follower,
-			TargetAddress:  targetAddr,
-			ActivitiesType: types.ActivitiesType_ACTIVITIES_FOLLOW,
-			Timestamp:      blockTime,
-		}
-		ms.k.SetActivitiesReceived(sdkCtx, activitiesReceived, targetAddr, follower)
-		count, b := ms.k.GetActivitiesReceivedCount(sdkCtx, targetAddr)
-		if !b {
-			types.LogError(ms.k.logger, "follow_user", types.ErrDatabaseOperation, "operation", "GetActivitiesReceivedCount", "target", targetAddr)
-		}
-		count += 1
-		if count > types.ActivitiesReceivedCount {
-			ms.k.DeleteLastActivitiesReceived(sdkCtx, targetAddr)
-		}
-		ms.k.SetActivitiesReceivedCount(sdkCtx, targetAddr, count)
+	profileFollower, _ := ms.k.GetProfile(sdkCtx, follower)
+	following := profileFollower.Following
+	following += 1
+	profileFollower.Following = following
+	ms.k.SetProfile(sdkCtx, profileFollower)
 
-		ms.k.SetFollowTime(sdkCtx, follower, targetAddr)
-	}
+	profileTarget, _ := ms.k.GetProfile(sdkCtx, targetAddr)
+	followers := profileTarget.Followers
+	followers += 1
+	profileTarget.Followers = followers
+	ms.k.SetProfile(sdkCtx, profileTarget)
 
-	return &types.MsgFollowResponse{}, nil
+	ms.k.AddToFollowingSearch(sdkCtx, follower, profileTarget)
+	ms.addActivity(sdkCtx, follower, targetAddr, types.ActivitiesType_ACTIVITIES_FOLLOW)
+	ms.k.SetFollowTime(sdkCtx, follower, targetAddr)
+}
+
+// addActivity records an activity of operator for targetAddr, dropping the
+// oldest one once the target has ActivitiesReceivedCount of them.
+func (ms msgServer) addActivity(sdkCtx sdk.Context, operator string, targetAddr string, activitiesType types.ActivitiesType) {
+	activitiesReceived := types.ActivitiesReceived{
+		Address:        operator,
+		TargetAddress:  targetAddr,
+		ActivitiesType: activitiesType,
+		Timestamp:      sdkCtx.BlockTime().Unix(),
+	}
+	ms.k.SetActivitiesReceived(sdkCtx, activitiesReceived, targetAddr, operator)
+	count, b := ms.k.GetActivitiesReceivedCount(sdkCtx, targetAddr)
+	if !b {
+		types.LogError(ms.k.logger, "add_activity", types.ErrDatabaseOperation, "operation", "GetActivitiesReceivedCount", "target", targetAddr)
+	}
+	count += 1
+	if count > types.ActivitiesReceivedCount {
+		ms.k.DeleteLastActivitiesReceived(sdkCtx, targetAddr)
+	}
+	ms.k.SetActivitiesReceivedCount(sdkCtx, targetAddr, count)
 }
 
 // Unfollow implements types.MsgServer.
 func (ms msgServer) Unfollow(ctx context.Context, msg *types.MsgUnfollowRequest) (*types.MsgUnfollowResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	follower := msg.Creator
-	targetAddr := msg.TargetAddr
+	// unfollowing a private account also withdraws a pending request
+	ms.k.DeleteFollowRequest(sdkCtx, msg.Creator, msg.TargetAddr)
+	ms.unfollow(sdkCtx, msg.Creator, msg.TargetAddr)
+
+	return &types.MsgUnfollowResponse{}, nil
+}
+
+// unfollow removes the follow from follower to targetAddr if there is one.
+func (ms msgServer) unfollow(sdkCtx sdk.Context, follower string, targetAddr string) {
 	isFollowing := ms.k.IsFollowing(sdkCtx, follower, targetAddr)
 	if isFollowing {
 		time, _ := ms.k.GetFollowTime(sdkCtx, follower, targetAddr)
@@ -508,20 +541,22 @@ func (ms msgServer) Unfollow(ctx context.Context, msg *types.MsgUnfollowRequest)
 		}
 		ms.k.DeleteFromFollowingSearch(sdkCtx, follower, profileTarget)
 	}
-
-	return &types.MsgUnfollowResponse{}, nil
 }
 
 // AddAdmin implements types.MsgServer.
 func (ms msgServer) AddAdmin(goCtx context.Context, msg *types.MsgAddAdminRequest) (*types.MsgAddAdminResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	adminAddress, _ := ms.k.GetAdminAddress(ctx)
-	if msg.Creator == adminAddress {
-		err := ms.k.AddAdmin(ctx, msg.Address)
-		if err != nil {
-			return nil, err
-		}
+	if !ms.k.HasPermission(ctx, msg.Creator, types.Permission_PERMISSION_MANAGE_ADMINS) {
+		types.LogError(ms.k.logger, "add_admin", types.ErrRequestDenied, "creator", msg.Creator)
+		return &types.MsgAddAdminResponse{
+			Status: false,
+		}, errors.Wrapf(types.ErrRequestDenied, "%s cannot manage admins", msg.Creator)
 	}
+	err := ms.k.AddAdmin(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	ms.k.AppendAuditLog(ctx, msg.Creator, types.AuditAction_AUDIT_ACTION_ADD_ADMIN, msg.Address, "", "admin")
 	return &types.MsgAddAdminResponse{
 		Status: true,
 	}, nil
@@ -530,17 +565,17 @@ func (ms msgServer) AddAdmin(goCtx context.Context, msg *types.MsgAddAdminReques
 // RemoveAdmin implements types.MsgServer.
 func (ms msgServer) RemoveAdmin(goCtx context.Context, msg *types.MsgRemoveAdminRequest) (*types.MsgRemoveAdminResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	adminAddress, _ := ms.k.GetAdminAddress(ctx)
-	if msg.Creator == adminAddress {
+	if ms.k.HasPermission(ctx, msg.Creator, types.Permission_PERMISSION_MANAGE_ADMINS) {
 		err := ms.k.RemoveAdmin(ctx, msg.Address)
 		if err != nil {
 			return nil, err
 		}
+		ms.k.AppendAuditLog(ctx, msg.Creator, types.AuditAction_AUDIT_ACTION_REMOVE_ADMIN, msg.Address, "admin", "")
 	} else {
 		types.LogError(ms.k.logger, "remove_admin", types.ErrRequestDenied, "creator", msg.Creator)
 		return &types.MsgRemoveAdminResponse{
 			Status: false,
-		}, types.NewInvalidRequestErrorf("address %s is not an admin", msg.Creator)
+		}, errors.Wrapf(types.ErrRequestDenied, "%s cannot manage admins", msg.Creator)
 	}
 	return &types.MsgRemoveAdminResponse{
 		Status: true,
@@ -550,17 +585,22 @@ func (ms msgServer) RemoveAdmin(goCtx context.Context, msg *types.MsgRemoveAdmin
 // AppointAdmin implements types.MsgServer.
 func (ms msgServer) ManageAdmin(goCtx context.Context, msg *types.MsgManageAdminRequest) (*types.MsgManageAdminResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	moderator, _ := ms.k.GetChiefModerator(ctx)
 	creator := msg.Creator
+	canManageAdmins := ms.k.HasPermission(ctx, creator, types.Permission_PERMISSION_MANAGE_ADMINS)
 	creatorProfile, _ := ms.k.GetProfile(ctx, creator)
 	json := msg.ManageJson
 	address := json.AdminAddress
 	adminProfile, _ := ms.k.GetProfile(ctx, address)
+	oldValue := adminAuditValue(adminProfile, ms.k.IsEditableAdmin(ctx, address))
 	action := msg.Action
 	if action == types.AdminActionAppoint {
 		lineProfile, _ := ms.k.GetProfile(ctx, json.LineManager)
 		level := json.AdminLevel
-		if (creatorProfile.AdminLevel > 4 || moderator == creator) || (json.LineManager != creator && address != json.LineManager && lineProfile.LineManager == creator && lineProfile.AdminLevel > 1 && lineProfile.AdminLevel < creatorProfile.AdminLevel) {
+		// a line manager's manager appoints below their own level and never themselves
+		managesLine := json.LineManager != creator && address != json.LineManager && address != creator &&
+			lineProfile.LineManager == creator && lineProfile.AdminLevel > 1 && lineProfile.AdminLevel < creatorProfile.AdminLevel &&
+			level < creatorProfile.AdminLevel
+		if canManageAdmins || managesLine {
 			lineAddr := json.LineManager
 			adminProfile.LineManager = lineAddr
 			adminProfile.AdminLevel = level
@@ -579,7 +619,8 @@ func (ms msgServer) ManageAdmin(goCtx context.Context, msg *types.MsgManageAdmin
 		}
 	} else if action == types.AdminActionRemove {
 		lineProfile, _ := ms.k.GetProfile(ctx, adminProfile.LineManager)
-		if (creatorProfile.AdminLevel > 4 || moderator == creator) || lineProfile.LineManager == creator {
+		managesLine := address != creator && lineProfile.LineManager == creator && adminProfile.AdminLevel < creatorProfile.AdminLevel
+		if canManageAdmins || managesLine {
 			adminProfile.LineManager = ""
 			adminProfile.AdminLevel = 0
 			ms.k.SetProfile(ctx, adminProfile)
@@ -600,6 +641,8 @@ func (ms msgServer) ManageAdmin(goCtx context.Context, msg *types.MsgManageAdmin
 			Status: false,
 		}, errors.Wrapf(types.ErrRequestDenied, "request denied")
 	}
+	newValue := adminAuditValue(adminProfile, ms.k.IsEditableAdmin(ctx, address))
+	ms.k.AppendAuditLog(ctx, creator, types.AuditAction_AUDIT_ACTION_MANAGE_ADMIN, address, oldValue, newValue)
 
 	return &types.MsgManageAdminResponse{
 		Status: true,
@@ -607,6 +650,11 @@ func (ms msgServer) ManageAdmin(goCtx context.Context, msg *types.MsgManageAdmin
 
 }
 
+// adminAuditValue describes the admin state of a profile in the audit log
+func adminAuditValue(profile types.Profile, editable bool) string {
+	return fmt.Sprintf("admin_level=%d line_manager=%s editable=%t", profile.AdminLevel, profile.LineManager, editable)
+}
+
 // SendMessage implements types.MsgServer.
 func (ms msgServer) SendMessage(goCtx context.Context, msg *types.SendMessageRequest) (*types.SendMessageResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -629,6 +677,9 @@ func (ms msgServer) SendMessage(goCtx context.Context, msg *types.SendMessageReq
 	if creator == targetAddr {
 		return nil, errors.Wrap(types.ErrInvalidRequest, "cannot send message to yourself")
 	}
+	if ms.k.IsBlocked(ctx, targetAddr, creator) {
+		return nil, errors.Wrapf(types.ErrUserBlocked, "%s has blocked %s", targetAddr, creator)
+	}
 
 	// Get txHash from transaction bytes
 	txBytes := ctx.TxBytes()
@@ -683,3 +734,354 @@ func (ms msgServer) SendMessage(goCtx context.Context, msg *types.SendMessageReq
 
 	return &types.SendMessageResponse{Status: true}, nil
 }
+
+// SetContentFilter implements types.MsgServer.
+func (ms msgServer) SetContentFilter(goCtx context.Context, msg *types.MsgSetContentFilterRequest) (*types.MsgSetContentFilterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+	if err := types.ValidateExcludedLabels(msg.ExcludedLabels); err != nil {
+		return nil, err
+	}
+
+	ms.k.CheckAndCreateUserHandle(ctx, msg.Creator)
+	profile, _ := ms.k.GetProfileForUpdate(ctx, msg.Creator)
+	profile.ExcludedLabels = msg.ExcludedLabels
+	ms.k.SetProfile(ctx, profile)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetContentFilter,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyLabels, strings.Join(msg.ExcludedLabels, ",")),
+	))
+	return &types.MsgSetContentFilterResponse{Status: true}, nil
+}
+
+// BlockUser implements types.MsgServer.
+func (ms msgServer) BlockUser(goCtx context.Context, msg *types.MsgBlockUserRequest) (*types.MsgBlockUserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TargetAddr); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid target address: %s", err)
+	}
+	if msg.Creator == msg.TargetAddr {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "cannot block yourself")
+	}
+	if ms.k.IsBlocked(ctx, msg.Creator, msg.TargetAddr) {
+		return nil, types.NewInvalidRequestErrorf("%s is already blocked", msg.TargetAddr)
+	}
+
+	// a block removes the follows and follow requests in both directions
+	ms.unfollow(ctx, msg.Creator, msg.TargetAddr)
+	ms.unfollow(ctx, msg.TargetAddr, msg.Creator)
+	ms.k.DeleteFollowRequest(ctx, msg.Creator, msg.TargetAddr)
+	ms.k.DeleteFollowRequest(ctx, msg.TargetAddr, msg.Creator)
+	ms.k.BlockUser(ctx, msg.Creator, msg.TargetAddr)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBlockUser,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyTarget, msg.TargetAddr),
+	))
+	return &types.MsgBlockUserResponse{Status: true}, nil
+}
+
+// UnblockUser implements types.MsgServer.
+func (ms msgServer) UnblockUser(goCtx context.Context, msg *types.MsgUnblockUserRequest) (*types.MsgUnblockUserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.IsBlocked(ctx, msg.Creator, msg.TargetAddr) {
+		return nil, types.NewInvalidRequestErrorf("%s is not blocked", msg.TargetAddr)
+	}
+	ms.k.UnblockUser(ctx, msg.Creator, msg.TargetAddr)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnblockUser,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyTarget, msg.TargetAddr),
+	))
+	return &types.MsgUnblockUserResponse{Status: true}, nil
+}
+
+// Mute implements types.MsgServer.
+func (ms msgServer) Mute(goCtx context.Context, msg *types.MsgMuteRequest) (*types.MsgMuteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+	value, err := types.NormalizeMuteValue(msg.MuteType, msg.Value)
+	if err != nil {
+		return nil, err
+	}
+	if msg.MuteType == types.MuteType_MUTE_TYPE_ACCOUNT {
+		if _, err := sdk.AccAddressFromBech32(value); err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid muted address: %s", err)
+		}
+		if value == msg.Creator {
+			return nil, errors.Wrap(types.ErrInvalidRequest, "cannot mute yourself")
+		}
+	}
+	if ms.k.HasMute(ctx, msg.Creator, msg.MuteType, value) {
+		return nil, types.NewInvalidRequestErrorf("%s is already muted", value)
+	}
+	if len(ms.k.GetMuteValues(ctx, msg.Creator, msg.MuteType)) >= types.MaxMutesPerType {
+		return nil, types.NewInvalidRequestErrorf("at most %d values of a type can be muted", types.MaxMutesPerType)
+	}
+	ms.k.SetMute(ctx, msg.Creator, msg.MuteType, value)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMute,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyMuteType, msg.MuteType.String()),
+		sdk.NewAttribute(types.AttributeKeyValue, value),
+	))
+	return &types.MsgMuteResponse{Status: true}, nil
+}
+
+// Unmute implements types.MsgServer.
+func (ms msgServer) Unmute(goCtx context.Context, msg *types.MsgUnmuteRequest) (*types.MsgUnmuteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	value, err := types.NormalizeMuteValue(msg.MuteType, msg.Value)
+	if err != nil {
+		return nil, err
+	}
+	if !ms.k.HasMute(ctx, msg.Creator, msg.MuteType, value) {
+		return nil, types.NewInvalidRequestErrorf("%s is not muted", value)
+	}
+	ms.k.DeleteMute(ctx, msg.Creator, msg.MuteType, value)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnmute,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyMuteType, msg.MuteType.String()),
+		sdk.NewAttribute(types.AttributeKeyValue, value),
+	))
+	return &types.MsgUnmuteResponse{Status: true}, nil
+}
+
+// SetPrivate implements types.MsgServer.
+func (ms msgServer) SetPrivate(goCtx context.Context, msg *types.MsgSetPrivateRequest) (*types.MsgSetPrivateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+	profile, _ := ms.k.GetProfile(ctx, msg.Creator)
+	if profile.Private == msg.Private {
+		return &types.MsgSetPrivateResponse{Status: true}, nil
+	}
+	profile.Private = msg.Private
+	ms.k.SetProfile(ctx, profile)
+
+	// a public account has nothing left to approve
+	if !msg.Private {
+		for _, requester := range ms.k.GetFollowRequesters(ctx, msg.Creator) {
+			ms.k.DeleteFollowRequest(ctx, requester, msg.Creator)
+			ms.follow(ctx, requester, msg.Creator)
+		}
+	}
+	if ms.k.hooks != nil {
+		ms.k.hooks.AfterPrivacyChanged(ctx, msg.Creator, msg.Private)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetPrivate,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPrivate, strconv.FormatBool(msg.Private)),
+	))
+	return &types.MsgSetPrivateResponse{Status: true}, nil
+}
+
+// ApproveFollow implements types.MsgServer.
+func (ms msgServer) ApproveFollow(goCtx context.Context, msg *types.MsgApproveFollowRequest) (*types.MsgApproveFollowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.DeleteFollowRequest(ctx, msg.Requester, msg.Creator) {
+		return nil, types.NewResourceNotFoundErrorf("no follow request from %s to %s", msg.Requester, msg.Creator)
+	}
+	if !ms.k.IsFollowing(ctx, msg.Requester, msg.Creator) {
+		ms.follow(ctx, msg.Requester, msg.Creator)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeApproveFollow,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyRequester, msg.Requester),
+	))
+	return &types.MsgApproveFollowResponse{Status: true}, nil
+}
+
+// RejectFollow implements types.MsgServer.
+func (ms msgServer) RejectFollow(goCtx context.Context, msg *types.MsgRejectFollowRequest) (*types.MsgRejectFollowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.k.DeleteFollowRequest(ctx, msg.Requester, msg.Creator) {
+		return nil, types.NewResourceNotFoundErrorf("no follow request from %s to %s", msg.Requester, msg.Creator)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRejectFollow,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyRequester, msg.Requester),
+	))
+	return &types.MsgRejectFollowResponse{Status: true}, nil
+}
+
+// CreateList implements types.MsgServer.
+func (ms msgServer) CreateList(goCtx context.Context, msg *types.MsgCreateListRequest) (*types.MsgCreateListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+	if err := types.ValidateListName(msg.Name); err != nil {
+		return nil, err
+	}
+	if len(ms.k.GetUserListsByOwner(ctx, msg.Creator)) >= types.MaxListsPerOwner {
+		return nil, types.NewInvalidRequestErrorf("a user can have at most %d lists", types.MaxListsPerOwner)
+	}
+
+	list := types.UserList{
+		Id:        ms.k.NextUserListId(ctx),
+		Owner:     msg.Creator,
+		Name:      strings.TrimSpace(msg.Name),
+		Private:   msg.Private,
+		CreatedAt: ctx.BlockTime().Unix(),
+	}
+	ms.k.SetUserList(ctx, list)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateList,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyListId, strconv.FormatUint(list.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyName, list.Name),
+		sdk.NewAttribute(types.AttributeKeyPrivate, strconv.FormatBool(list.Private)),
+	))
+	return &types.MsgCreateListResponse{ListId: list.Id}, nil
+}
+
+// RenameList implements types.MsgServer.
+func (ms msgServer) RenameList(goCtx context.Context, msg *types.MsgRenameListRequest) (*types.MsgRenameListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	list, err := ms.getOwnedList(ctx, msg.Creator, msg.ListId)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateListName(msg.Name); err != nil {
+		return nil, err
+	}
+	list.Name = strings.TrimSpace(msg.Name)
+	ms.k.SetUserList(ctx, list)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRenameList,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyListId, strconv.FormatUint(list.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyName, list.Name),
+	))
+	return &types.MsgRenameListResponse{Status: true}, nil
+}
+
+// DeleteList implements types.MsgServer.
+func (ms msgServer) DeleteList(goCtx context.Context, msg *types.MsgDeleteListRequest) (*types.MsgDeleteListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	list, err := ms.getOwnedList(ctx, msg.Creator, msg.ListId)
+	if err != nil {
+		return nil, err
+	}
+	ms.k.DeleteUserList(ctx, list)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeleteList,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyListId, strconv.FormatUint(list.Id, 10)),
+	))
+	return &types.MsgDeleteListResponse{Status: true}, nil
+}
+
+// AddListMembers implements types.MsgServer.
+func (ms msgServer) AddListMembers(goCtx context.Context, msg *types.MsgAddListMembersRequest) (*types.MsgAddListMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	list, err := ms.getOwnedList(ctx, msg.Creator, msg.ListId)
+	if err != nil {
+		return nil, err
+	}
+	var added []string
+	for _, member := range msg.Members {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid member address: %s", err)
+		}
+		if ms.k.IsBlocked(ctx, member, msg.Creator) {
+			return nil, errors.Wrapf(types.ErrUserBlocked, "%s has blocked %s", member, msg.Creator)
+		}
+		if ms.k.IsListMember(ctx, list.Id, member) {
+			continue
+		}
+		if list.MemberCount >= ms.k.GetMaxListMembers(ctx) {
+			return nil, types.NewInvalidRequestErrorf("a list can hold at most %d accounts", ms.k.GetMaxListMembers(ctx))
+		}
+		ms.k.SetListMember(ctx, list.Id, member)
+		list.MemberCount++
+		added = append(added, member)
+	}
+	ms.k.SetUserList(ctx, list)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddListMembers,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyListId, strconv.FormatUint(list.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(added, ",")),
+	))
+	return &types.MsgAddListMembersResponse{Status: true}, nil
+}
+
+// RemoveListMembers implements types.MsgServer.
+func (ms msgServer) RemoveListMembers(goCtx context.Context, msg *types.MsgRemoveListMembersRequest) (*types.MsgRemoveListMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	list, err := ms.getOwnedList(ctx, msg.Creator, msg.ListId)
+	if err != nil {
+		return nil, err
+	}
+	var removed []string
+	for _, member := range msg.Members {
+		if !ms.k.IsListMember(ctx, list.Id, member) {
+			continue
+		}
+		ms.k.DeleteListMember(ctx, list.Id, member)
+		list.MemberCount--
+		removed = append(removed, member)
+	}
+	ms.k.SetUserList(ctx, list)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveListMembers,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyListId, strconv.FormatUint(list.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(removed, ",")),
+	))
+	return &types.MsgRemoveListMembersResponse{Status: true}, nil
+}
+
+// getOwnedList returns the user list with the given id when creator owns it.
+func (ms msgServer) getOwnedList(ctx sdk.Context, creator string, listId uint64) (types.UserList, error) {
+	list, found := ms.k.GetUserList(ctx, listId)
+	if !found {
+		return list, types.NewResourceNotFoundErrorf("list %d not found", listId)
+	}
+	if list.Owner != creator {
+		return list, errors.Wrapf(types.ErrRequestDenied, "%s does not own list %d", creator, listId)
+	}
+	return list, nil
+}
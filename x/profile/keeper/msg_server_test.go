@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -54,3 +55,417 @@ func TestParams(t *testing.T) {
 		})
 	}
 }
+
+func TestSetContentFilter(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice := f.addrs[0].String()
+
+	_, err := f.msgServer.SetContentFilter(f.ctx, &types.MsgSetContentFilterRequest{Creator: alice, ExcludedLabels: []string{"nsfw", "nsfw"}})
+	require.Error(err)
+	_, err = f.msgServer.SetContentFilter(f.ctx, &types.MsgSetContentFilterRequest{Creator: alice, ExcludedLabels: []string{"nsfv"}})
+	require.Error(err)
+
+	_, err = f.msgServer.SetContentFilter(f.ctx, &types.MsgSetContentFilterRequest{Creator: alice, ExcludedLabels: []string{"nsfw", "spoiler"}})
+	require.NoError(err)
+	profile, _ := f.k.GetProfile(f.ctx, alice)
+	require.Equal([]string{"nsfw", "spoiler"}, profile.ExcludedLabels)
+	require.NotEmpty(profile.UserHandle)
+
+	_, err = f.msgServer.SetContentFilter(f.ctx, &types.MsgSetContentFilterRequest{Creator: alice})
+	require.NoError(err)
+	profile, _ = f.k.GetProfile(f.ctx, alice)
+	require.Empty(profile.ExcludedLabels)
+}
+
+func TestBlockUser(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+	ctx := f.ctx
+
+	_, err := f.msgServer.Follow(ctx, &types.MsgFollowRequest{Creator: alice, TargetAddr: bob})
+	require.NoError(err)
+	_, err = f.msgServer.Follow(ctx, &types.MsgFollowRequest{Creator: bob, TargetAddr: alice})
+	require.NoError(err)
+
+	_, err = f.msgServer.BlockUser(ctx, &types.MsgBlockUserRequest{Creator: alice, TargetAddr: alice})
+	require.Error(err)
+	_, err = f.msgServer.BlockUser(ctx, &types.MsgBlockUserRequest{Creator: alice, TargetAddr: bob})
+	require.NoError(err)
+	_, err = f.msgServer.BlockUser(ctx, &types.MsgBlockUserRequest{Creator: alice, TargetAddr: bob})
+	require.Error(err)
+
+	// the block removes the follows in both directions
+	require.False(f.k.IsFollowing(ctx, alice, bob))
+	require.False(f.k.IsFollowing(ctx, bob, alice))
+	profile, _ := f.k.GetProfile(ctx, alice)
+	require.Zero(profile.Following)
+	require.Zero(profile.Followers)
+
+	_, err = f.msgServer.Follow(ctx, &types.MsgFollowRequest{Creator: bob, TargetAddr: alice})
+	require.ErrorIs(err, types.ErrUserBlocked)
+
+	blocked, err := f.queryServer.QueryBlockedUsers(ctx, &types.QueryBlockedUsersRequest{Address: alice})
+	require.NoError(err)
+	require.Len(blocked.Profiles, 1)
+	require.Equal(bob, blocked.Profiles[0].WalletAddress)
+
+	_, err = f.msgServer.UnblockUser(ctx, &types.MsgUnblockUserRequest{Creator: alice, TargetAddr: bob})
+	require.NoError(err)
+	_, err = f.msgServer.UnblockUser(ctx, &types.MsgUnblockUserRequest{Creator: alice, TargetAddr: bob})
+	require.Error(err)
+	blocked, err = f.queryServer.QueryBlockedUsers(ctx, &types.QueryBlockedUsersRequest{Address: alice})
+	require.NoError(err)
+	require.Empty(blocked.Profiles)
+	_, err = f.msgServer.Follow(ctx, &types.MsgFollowRequest{Creator: bob, TargetAddr: alice})
+	require.NoError(err)
+}
+
+func TestMute(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob := f.addrs[0].String(), f.addrs[1].String()
+
+	mute := func(muteType types.MuteType, value string) error {
+		_, err := f.msgServer.Mute(f.ctx, &types.MsgMuteRequest{Creator: alice, MuteType: muteType, Value: value})
+		return err
+	}
+	require.Error(mute(types.MuteType_MUTE_TYPE_ACCOUNT, alice))
+	require.Error(mute(types.MuteType_MUTE_TYPE_ACCOUNT, "not-an-address"))
+	require.Error(mute(types.MuteType_MUTE_TYPE_UNSPECIFIED, "spoilers"))
+	require.Error(mute(types.MuteType_MUTE_TYPE_KEYWORD, " "))
+	require.NoError(mute(types.MuteType_MUTE_TYPE_ACCOUNT, bob))
+	require.NoError(mute(types.MuteType_MUTE_TYPE_KEYWORD, " Spoilers "))
+	require.Error(mute(types.MuteType_MUTE_TYPE_KEYWORD, "spoilers"))
+
+	res, err := f.queryServer.QueryMutes(f.ctx, &types.QueryMutesRequest{Address: alice})
+	require.NoError(err)
+	require.Equal([]string{bob}, res.Mutes.Accounts)
+	require.Equal([]string{"spoilers"}, res.Mutes.Keywords)
+	require.Empty(res.Mutes.Topics)
+
+	_, err = f.msgServer.Unmute(f.ctx, &types.MsgUnmuteRequest{Creator: alice, MuteType: types.MuteType_MUTE_TYPE_KEYWORD, Value: "SPOILERS"})
+	require.NoError(err)
+	_, err = f.msgServer.Unmute(f.ctx, &types.MsgUnmuteRequest{Creator: alice, MuteType: types.MuteType_MUTE_TYPE_KEYWORD, Value: "spoilers"})
+	require.Error(err)
+	require.Empty(f.k.GetMuteValues(f.ctx, alice, types.MuteType_MUTE_TYPE_KEYWORD))
+}
+
+func TestPermissions(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+
+	require.NoError(f.k.Params.Set(f.ctx, types.Params{AdminAddress: alice}))
+	profile, _ := f.k.GetProfile(f.ctx, bob)
+	profile.AdminLevel = 2
+	f.k.SetProfile(f.ctx, profile)
+
+	require.Equal(types.Role_ROLE_SUPER_ADMIN, f.k.GetRole(f.ctx, alice))
+	require.Equal(types.Role_ROLE_EDITOR, f.k.GetRole(f.ctx, bob))
+	require.Equal(types.Role_ROLE_NONE, f.k.GetRole(f.ctx, carol))
+	require.True(f.k.HasPermission(f.ctx, bob, types.Permission_PERMISSION_EDIT_TOPICS))
+	require.False(f.k.HasPermission(f.ctx, bob, types.Permission_PERMISSION_MANAGE_CATEGORIES))
+
+	// only holders of the manage admins permission can add admins
+	_, err := f.msgServer.AddAdmin(f.ctx, &types.MsgAddAdminRequest{Creator: bob, Address: carol})
+	require.ErrorIs(err, types.ErrRequestDenied)
+	_, err = f.msgServer.AddAdmin(f.ctx, &types.MsgAddAdminRequest{Creator: alice, Address: carol})
+	require.NoError(err)
+
+	res, err := f.queryServer.QueryPermissions(f.ctx, &types.QueryPermissionsRequest{Address: carol})
+	require.NoError(err)
+	require.Equal(types.Role_ROLE_ADMIN, res.Role)
+	require.Contains(res.Permissions, types.Permission_PERMISSION_MANAGE_CATEGORIES)
+	require.NotContains(res.Permissions, types.Permission_PERMISSION_MANAGE_ADMINS)
+
+	holders, err := f.queryServer.QueryPermissionHolders(f.ctx, &types.QueryPermissionHoldersRequest{Permission: types.Permission_PERMISSION_MANAGE_CATEGORIES})
+	require.NoError(err)
+	var addresses []string
+	for _, holder := range holders.Holders {
+		addresses = append(addresses, holder.Address)
+	}
+	require.ElementsMatch([]string{alice, carol}, addresses)
+
+	// dropping the admin level removes the role
+	profile.AdminLevel = 0
+	f.k.SetProfile(f.ctx, profile)
+	holders, err = f.queryServer.QueryPermissionHolders(f.ctx, &types.QueryPermissionHoldersRequest{Permission: types.Permission_PERMISSION_EDIT_TOPICS})
+	require.NoError(err)
+	require.Len(holders.Holders, 2)
+	_, err = f.queryServer.QueryPermissionHolders(f.ctx, &types.QueryPermissionHoldersRequest{})
+	require.Error(err)
+}
+
+func TestManageAdminLineManager(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol, dave := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String(), f.addrs[3].String()
+
+	setAdmin := func(address string, level uint64, lineManager string) {
+		profile, _ := f.k.GetProfile(f.ctx, address)
+		profile.AdminLevel = level
+		profile.LineManager = lineManager
+		f.k.SetProfile(f.ctx, profile)
+	}
+	setAdmin(carol, 3, "")
+	setAdmin(dave, 2, carol)
+	manage := func(action string, address string, level uint64) error {
+		_, err := f.msgServer.ManageAdmin(f.ctx, &types.MsgManageAdminRequest{
+			Creator:    carol,
+			Action:     action,
+			ManageJson: &types.ManageOptions{LineManager: dave, AdminAddress: address, AdminLevel: level},
+		})
+		return err
+	}
+
+	// carol appoints below her own level and never herself
+	require.ErrorIs(manage(types.AdminActionAppoint, bob, 5), types.ErrRequestDenied)
+	require.ErrorIs(manage(types.AdminActionAppoint, bob, 3), types.ErrRequestDenied)
+	require.ErrorIs(manage(types.AdminActionAppoint, carol, 2), types.ErrRequestDenied)
+	require.NoError(manage(types.AdminActionAppoint, bob, 2))
+	profile, _ := f.k.GetProfile(f.ctx, bob)
+	require.Equal(uint64(2), profile.AdminLevel)
+	require.Equal(types.Role_ROLE_EDITOR, f.k.GetRole(f.ctx, bob))
+
+	// and removes only admins below her level
+	setAdmin(alice, 4, dave)
+	require.ErrorIs(manage(types.AdminActionRemove, alice, 0), types.ErrRequestDenied)
+	require.NoError(manage(types.AdminActionRemove, bob, 0))
+	profile, _ = f.k.GetProfile(f.ctx, bob)
+	require.Zero(profile.AdminLevel)
+}
+
+func TestRateLimit(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice := f.addrs[0].String()
+	const msgType = "/post.v1.MsgCreatePost"
+
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{{MsgType: msgType, PerBlock: 2, PerHour: 3}}
+	params.RateLimitLevelBonus = 50
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	ctx := f.ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+	require.NoError(f.k.ConsumeRateLimit(ctx, alice, msgType))
+	require.NoError(f.k.ConsumeRateLimit(ctx, alice, msgType))
+	require.ErrorIs(f.k.ConsumeRateLimit(ctx, alice, msgType), types.ErrRateLimited)
+	// other message types are not limited
+	require.NoError(f.k.ConsumeRateLimit(ctx, alice, "/profile.v1.MsgFollowRequest"))
+
+	// the next block has a fresh block quota but shares the hourly one
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Unix(1010, 0))
+	require.NoError(f.k.ConsumeRateLimit(ctx, alice, msgType))
+	require.ErrorIs(f.k.ConsumeRateLimit(ctx, alice, msgType), types.ErrRateLimited)
+
+	// higher levels get larger quotas
+	f.k.SetProfile(ctx, types.Profile{WalletAddress: alice, Level: 2})
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(time.Unix(1020, 0))
+	require.NoError(f.k.ConsumeRateLimit(ctx, alice, msgType))
+	require.NoError(f.k.ConsumeRateLimit(ctx, alice, msgType))
+	require.NoError(f.k.ConsumeRateLimit(ctx, alice, msgType))
+	require.ErrorIs(f.k.ConsumeRateLimit(ctx, alice, msgType), types.ErrRateLimited)
+
+	// the hourly window resets
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(time.Unix(1000+types.RateLimitWindow, 0))
+	require.NoError(f.k.ConsumeRateLimit(ctx, alice, msgType))
+
+	// the EndBlocker keeps a renewed counter and drops it once its window ends
+	require.NoError(f.k.EndBlocker(ctx))
+	require.True(f.k.HasRateLimitCounter(ctx, alice, msgType))
+	require.NoError(f.k.EndBlocker(ctx.WithBlockTime(time.Unix(1000+2*types.RateLimitWindow, 0))))
+	require.False(f.k.HasRateLimitCounter(ctx, alice, msgType))
+}
+
+func TestAuditLog(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+
+	require.NoError(f.k.Params.Set(f.ctx, types.Params{AdminAddress: alice}))
+	_, err := f.msgServer.AddAdmin(f.ctx, &types.MsgAddAdminRequest{Creator: alice, Address: bob})
+	require.NoError(err)
+	_, err = f.msgServer.AddAdmin(f.ctx, &types.MsgAddAdminRequest{Creator: alice, Address: carol})
+	require.NoError(err)
+	_, err = f.msgServer.RemoveAdmin(f.ctx, &types.MsgRemoveAdminRequest{Creator: alice, Address: carol})
+	require.NoError(err)
+
+	// denied actions are not recorded
+	_, err = f.msgServer.AddAdmin(f.ctx, &types.MsgAddAdminRequest{Creator: carol, Address: carol})
+	require.Error(err)
+
+	res, err := f.queryServer.QueryAuditLog(f.ctx, &types.QueryAuditLogRequest{CountTotal: true})
+	require.NoError(err)
+	require.Equal(uint64(3), res.Total)
+	require.Equal(types.AuditAction_AUDIT_ACTION_REMOVE_ADMIN, res.Entries[0].Action)
+	require.Equal(carol, res.Entries[0].Target)
+	require.Equal(alice, res.Entries[0].Actor)
+
+	res, err = f.queryServer.QueryAuditLog(f.ctx, &types.QueryAuditLogRequest{Action: types.AuditAction_AUDIT_ACTION_ADD_ADMIN})
+	require.NoError(err)
+	require.Len(res.Entries, 2)
+	require.Equal(bob, res.Entries[1].Target)
+
+	res, err = f.queryServer.QueryAuditLog(f.ctx, &types.QueryAuditLogRequest{Actor: alice, Action: types.AuditAction_AUDIT_ACTION_REMOVE_ADMIN})
+	require.NoError(err)
+	require.Len(res.Entries, 1)
+
+	res, err = f.queryServer.QueryAuditLog(f.ctx, &types.QueryAuditLogRequest{Actor: carol})
+	require.NoError(err)
+	require.Empty(res.Entries)
+
+	res, err = f.queryServer.QueryAuditLog(f.ctx, &types.QueryAuditLogRequest{Page: 1, Limit: 2})
+	require.NoError(err)
+	require.Len(res.Entries, 1)
+	require.Equal(types.AuditAction_AUDIT_ACTION_ADD_ADMIN, res.Entries[0].Action)
+	require.Zero(res.Total)
+}
+
+func TestFollowRequests(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String()
+
+	_, err := f.msgServer.SetPrivate(f.ctx, &types.MsgSetPrivateRequest{Creator: bob, Private: true})
+	require.NoError(err)
+	require.True(f.k.IsPrivate(f.ctx, bob))
+
+	// following a private account waits for its approval
+	res, err := f.msgServer.Follow(f.ctx, &types.MsgFollowRequest{Creator: alice, TargetAddr: bob})
+	require.NoError(err)
+	require.True(res.Pending)
+	require.False(f.k.IsFollowing(f.ctx, alice, bob))
+	_, err = f.msgServer.Follow(f.ctx, &types.MsgFollowRequest{Creator: carol, TargetAddr: bob})
+	require.NoError(err)
+
+	received, err := f.queryServer.QueryFollowRequests(f.ctx, &types.QueryFollowRequestsRequest{Address: bob})
+	require.NoError(err)
+	require.Equal(uint64(2), received.Total)
+	require.Len(received.Profiles, 2)
+	sent, err := f.queryServer.QuerySentFollowRequests(f.ctx, &types.QueryFollowRequestsRequest{Address: alice})
+	require.NoError(err)
+	require.Len(sent.Requests, 1)
+	require.Equal(bob, sent.Requests[0].Target)
+	require.Equal(bob, sent.Profiles[0].WalletAddress)
+
+	_, err = f.msgServer.ApproveFollow(f.ctx, &types.MsgApproveFollowRequest{Creator: bob, Requester: alice})
+	require.NoError(err)
+	require.True(f.k.IsFollowing(f.ctx, alice, bob))
+	require.True(f.k.CanViewPosts(f.ctx, alice, bob))
+	require.False(f.k.CanViewPosts(f.ctx, carol, bob))
+
+	_, err = f.msgServer.RejectFollow(f.ctx, &types.MsgRejectFollowRequest{Creator: bob, Requester: carol})
+	require.NoError(err)
+	require.False(f.k.IsFollowing(f.ctx, carol, bob))
+	_, err = f.msgServer.RejectFollow(f.ctx, &types.MsgRejectFollowRequest{Creator: bob, Requester: carol})
+	require.ErrorIs(err, types.ErrResourceNotFound)
+
+	// going public approves the requests left
+	_, err = f.msgServer.Follow(f.ctx, &types.MsgFollowRequest{Creator: carol, TargetAddr: bob})
+	require.NoError(err)
+	_, err = f.msgServer.SetPrivate(f.ctx, &types.MsgSetPrivateRequest{Creator: bob, Private: false})
+	require.NoError(err)
+	require.True(f.k.IsFollowing(f.ctx, carol, bob))
+	require.False(f.k.HasFollowRequest(f.ctx, carol, bob))
+	profile, _ := f.k.GetProfile(f.ctx, bob)
+	require.Equal(uint64(2), profile.Followers)
+}
+
+func TestFollowGraph(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol, dave := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String(), f.addrs[3].String()
+
+	follow := func(follower, target string) {
+		_, err := f.msgServer.Follow(f.ctx, &types.MsgFollowRequest{Creator: follower, TargetAddr: target})
+		require.NoError(err)
+	}
+	follow(alice, carol)
+	follow(carol, bob)
+	follow(dave, bob)
+	follow(dave, alice)
+
+	// carol follows bob and alice follows carol
+	known, err := f.queryServer.QueryFollowersYouKnow(f.ctx, &types.QueryFollowersYouKnowRequest{Viewer: alice, Address: bob})
+	require.NoError(err)
+	require.Equal(uint64(1), known.Total)
+	require.False(known.Truncated)
+	require.Equal(carol, known.Profiles[0].WalletAddress)
+
+	mutual, err := f.queryServer.QueryMutualFollowers(f.ctx, &types.QueryMutualFollowersRequest{AddressA: alice, AddressB: bob})
+	require.NoError(err)
+	require.Equal(uint64(1), mutual.Total)
+	require.Equal(dave, mutual.Profiles[0].WalletAddress)
+
+	// the total counts every match, the page only holds limit of them
+	follow(carol, alice)
+	mutual, err = f.queryServer.QueryMutualFollowers(f.ctx, &types.QueryMutualFollowersRequest{AddressA: bob, AddressB: alice, Limit: 1})
+	require.NoError(err)
+	require.Equal(uint64(2), mutual.Total)
+	require.Len(mutual.Profiles, 1)
+	mutual, err = f.queryServer.QueryMutualFollowers(f.ctx, &types.QueryMutualFollowersRequest{AddressA: bob, AddressB: alice, Page: 2, Limit: 1})
+	require.NoError(err)
+	require.Empty(mutual.Profiles)
+
+	_, err = f.queryServer.QueryFollowersYouKnow(f.ctx, &types.QueryFollowersYouKnowRequest{Viewer: alice})
+	require.Error(err)
+}
+
+func TestUserLists(t *testing.T) {
+	f := SetupTest(t)
+	require := require.New(t)
+	alice, bob, carol, dave := f.addrs[0].String(), f.addrs[1].String(), f.addrs[2].String(), f.addrs[3].String()
+	params := types.DefaultParams()
+	params.MaxListMembers = 2
+	require.NoError(f.k.Params.Set(f.ctx, params))
+
+	_, err := f.msgServer.CreateList(f.ctx, &types.MsgCreateListRequest{Creator: alice, Name: " "})
+	require.Error(err)
+	public, err := f.msgServer.CreateList(f.ctx, &types.MsgCreateListRequest{Creator: alice, Name: "Cosmos devs"})
+	require.NoError(err)
+	private, err := f.msgServer.CreateList(f.ctx, &types.MsgCreateListRequest{Creator: alice, Name: "close", Private: true})
+	require.NoError(err)
+
+	_, err = f.msgServer.AddListMembers(f.ctx, &types.MsgAddListMembersRequest{Creator: alice, ListId: public.ListId, Members: []string{bob, carol, bob}})
+	require.NoError(err)
+	_, err = f.msgServer.AddListMembers(f.ctx, &types.MsgAddListMembersRequest{Creator: alice, ListId: public.ListId, Members: []string{dave}})
+	require.Error(err)
+	_, err = f.msgServer.AddListMembers(f.ctx, &types.MsgAddListMembersRequest{Creator: bob, ListId: public.ListId, Members: []string{dave}})
+	require.ErrorIs(err, types.ErrRequestDenied)
+
+	// lists leave the follow graph as is
+	require.False(f.k.IsFollowing(f.ctx, alice, bob))
+
+	members, err := f.queryServer.QueryListMembers(f.ctx, &types.QueryListMembersRequest{ListId: public.ListId, Viewer: bob})
+	require.NoError(err)
+	require.Equal(uint64(2), members.Total)
+	require.Equal(uint64(2), members.List.MemberCount)
+
+	_, err = f.msgServer.RenameList(f.ctx, &types.MsgRenameListRequest{Creator: alice, ListId: public.ListId, Name: "Devs"})
+	require.NoError(err)
+	_, err = f.msgServer.RemoveListMembers(f.ctx, &types.MsgRemoveListMembersRequest{Creator: alice, ListId: public.ListId, Members: []string{carol, dave}})
+	require.NoError(err)
+	list, found := f.k.GetUserList(f.ctx, public.ListId)
+	require.True(found)
+	require.Equal("Devs", list.Name)
+	require.Equal(uint64(1), list.MemberCount)
+	require.False(f.k.IsListMember(f.ctx, public.ListId, carol))
+
+	// private lists are only visible to their owner
+	lists, err := f.queryServer.QueryLists(f.ctx, &types.QueryListsRequest{Owner: alice, Viewer: bob})
+	require.NoError(err)
+	require.Len(lists.Lists, 1)
+	lists, err = f.queryServer.QueryLists(f.ctx, &types.QueryListsRequest{Owner: alice, Viewer: alice})
+	require.NoError(err)
+	require.Len(lists.Lists, 2)
+	_, err = f.queryServer.QueryListMembers(f.ctx, &types.QueryListMembersRequest{ListId: private.ListId, Viewer: bob})
+	require.Error(err)
+
+	_, err = f.msgServer.DeleteList(f.ctx, &types.MsgDeleteListRequest{Creator: alice, ListId: public.ListId})
+	require.NoError(err)
+	_, found = f.k.GetUserList(f.ctx, public.ListId)
+	require.False(found)
+	require.Empty(f.k.GetListMembers(f.ctx, public.ListId))
+}
@@ -118,6 +118,194 @@ func (k Querier) QueryFollowers(goCtx context.Context, req *types.QueryFollowers
 	}, nil
 }
 
+// QueryFollowersYouKnow implements types.QueryServer.
+func (k Querier) QueryFollowersYouKnow(goCtx context.Context, req *types.QueryFollowersYouKnowRequest) (*types.QueryFollowersYouKnowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Viewer == "" || req.Address == "" {
+		return nil, types.ToGRPCError(types.NewInvalidRequestError("viewer and address are required"))
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.PageSize
+	}
+	addresses, total, truncated := k.Keeper.GetFollowersYouKnow(ctx, req.Viewer, req.Address, req.Page, limit)
+	return &types.QueryFollowersYouKnowResponse{
+		Profiles:  k.profilesOf(ctx, addresses),
+		Total:     total,
+		Truncated: truncated,
+	}, nil
+}
+
+// QueryMutualFollowers implements types.QueryServer.
+func (k Querier) QueryMutualFollowers(goCtx context.Context, req *types.QueryMutualFollowersRequest) (*types.QueryMutualFollowersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.AddressA == "" || req.AddressB == "" {
+		return nil, types.ToGRPCError(types.NewInvalidRequestError("address_a and address_b are required"))
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.PageSize
+	}
+	addresses, total, truncated := k.Keeper.GetMutualFollowers(ctx, req.AddressA, req.AddressB, req.Page, limit)
+	return &types.QueryMutualFollowersResponse{
+		Profiles:  k.profilesOf(ctx, addresses),
+		Total:     total,
+		Truncated: truncated,
+	}, nil
+}
+
+// pageLimit returns the page size of a paginated query, the default page size
+// when limit is unset and at most MaxPageLimit.
+func pageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return types.PageSize
+	}
+	if limit > types.MaxPageLimit {
+		return types.MaxPageLimit
+	}
+	return limit
+}
+
+// profilesOf returns the profiles of addresses in the same order.
+func (k Querier) profilesOf(ctx sdk.Context, addresses []string) []*types.Profile {
+	profiles := make([]*types.Profile, 0, len(addresses))
+	for _, address := range addresses {
+		profile, _ := k.GetProfile(ctx, address)
+		profiles = append(profiles, &profile)
+	}
+	return profiles
+}
+
+// QueryPermissions implements types.QueryServer.
+func (k Querier) QueryPermissions(goCtx context.Context, req *types.QueryPermissionsRequest) (*types.QueryPermissionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	role := k.Keeper.GetRole(ctx, req.Address)
+	return &types.QueryPermissionsResponse{
+		Role:        role,
+		Permissions: role.Permissions(),
+	}, nil
+}
+
+// QueryPermissionHolders implements types.QueryServer.
+func (k Querier) QueryPermissionHolders(goCtx context.Context, req *types.QueryPermissionHoldersRequest) (*types.QueryPermissionHoldersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, ok := types.Permission_name[int32(req.Permission)]; !ok || req.Permission == types.Permission_PERMISSION_UNSPECIFIED {
+		return nil, types.ToGRPCError(types.NewInvalidRequestErrorf("invalid permission %d", req.Permission))
+	}
+	var holders []*types.PermissionHolder
+	for _, holder := range k.Keeper.GetPermissionHolders(ctx, req.Permission) {
+		holderCopy := holder
+		holders = append(holders, &holderCopy)
+	}
+	return &types.QueryPermissionHoldersResponse{
+		Holders: holders,
+	}, nil
+}
+
+// QueryMutes implements types.QueryServer.
+func (k Querier) QueryMutes(goCtx context.Context, req *types.QueryMutesRequest) (*types.QueryMutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mutes := k.Keeper.GetMutes(ctx, req.Address)
+	return &types.QueryMutesResponse{
+		Mutes: &mutes,
+	}, nil
+}
+
+// QueryBlockedUsers implements types.QueryServer.
+func (k Querier) QueryBlockedUsers(goCtx context.Context, req *types.QueryBlockedUsersRequest) (*types.QueryBlockedUsersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blocked, _, err := k.Keeper.GetBlockedPagination(ctx, req.Address, req.Page, pageLimit(req.Limit))
+	if err != nil {
+		return nil, types.ToGRPCError(err)
+	}
+
+	var profiles []*types.Profile
+	for _, address := range blocked {
+		profile, _ := k.GetProfile(ctx, address)
+		profileCopy := profile
+		profiles = append(profiles, &profileCopy)
+	}
+	return &types.QueryBlockedUsersResponse{
+		Profiles: profiles,
+	}, nil
+}
+
+// QueryFollowRequests implements types.QueryServer.
+func (k Querier) QueryFollowRequests(goCtx context.Context, req *types.QueryFollowRequestsRequest) (*types.QueryFollowRequestsResponse, error) {
+	return k.followRequests(sdk.UnwrapSDKContext(goCtx), req, false)
+}
+
+// QuerySentFollowRequests implements types.QueryServer.
+func (k Querier) QuerySentFollowRequests(goCtx context.Context, req *types.QueryFollowRequestsRequest) (*types.QueryFollowRequestsResponse, error) {
+	return k.followRequests(sdk.UnwrapSDKContext(goCtx), req, true)
+}
+
+// followRequests lists the follow requests received or sent by req.Address
+// with the profile of the other account of each.
+func (k Querier) followRequests(ctx sdk.Context, req *types.QueryFollowRequestsRequest, sent bool) (*types.QueryFollowRequestsResponse, error) {
+	requests, pageRes, err := k.Keeper.GetFollowRequestsPagination(ctx, req.Address, sent, req.Page, pageLimit(req.Limit))
+	if err != nil {
+		return nil, types.ToGRPCError(err)
+	}
+
+	res := &types.QueryFollowRequestsResponse{Total: pageRes.Total}
+	for i := range requests {
+		other := requests[i].Requester
+		if sent {
+			other = requests[i].Target
+		}
+		profile, _ := k.GetProfile(ctx, other)
+		res.Requests = append(res.Requests, &requests[i])
+		res.Profiles = append(res.Profiles, &profile)
+	}
+	return res, nil
+}
+
+// QueryLists implements types.QueryServer.
+func (k Querier) QueryLists(goCtx context.Context, req *types.QueryListsRequest) (*types.QueryListsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := &types.QueryListsResponse{}
+	for _, list := range k.Keeper.GetUserListsByOwner(ctx, req.Owner) {
+		if list.VisibleTo(req.Viewer) {
+			res.Lists = append(res.Lists, &list)
+		}
+	}
+	return res, nil
+}
+
+// QueryListMembers implements types.QueryServer.
+func (k Querier) QueryListMembers(goCtx context.Context, req *types.QueryListMembersRequest) (*types.QueryListMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	list, found := k.Keeper.GetUserList(ctx, req.ListId)
+	if !found || !list.VisibleTo(req.Viewer) {
+		return nil, types.ToGRPCError(types.NewResourceNotFoundErrorf("list %d not found", req.ListId))
+	}
+	members, pageRes, err := k.Keeper.GetListMembersPagination(ctx, req.ListId, req.Page, pageLimit(req.Limit))
+	if err != nil {
+		return nil, types.ToGRPCError(err)
+	}
+	return &types.QueryListMembersResponse{
+		List:     &list,
+		Profiles: k.profilesOf(ctx, members),
+		Total:    pageRes.Total,
+	}, nil
+}
+
+// QueryAuditLog implements types.QueryServer.
+func (k Querier) QueryAuditLog(goCtx context.Context, req *types.QueryAuditLogRequest) (*types.QueryAuditLogResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries, pageRes, err := k.Keeper.GetAuditLog(ctx, req.Actor, req.Action, req.Page, pageLimit(req.Limit), req.CountTotal)
+	if err != nil {
+		return nil, types.ToGRPCError(err)
+	}
+
+	res := &types.QueryAuditLogResponse{Total: pageRes.Total}
+	for i := range entries {
+		res.Entries = append(res.Entries, &entries[i])
+	}
+	return res, nil
+}
+
 // GetMentionSuggestions implements types.QueryServer.
 func (k Querier) GetMentionSuggestions(goCtx context.Context, req *types.QueryGetMentionSuggestionsRequest) (*types.QueryGetMentionSuggestionsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
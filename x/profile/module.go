@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -10,6 +11,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,7 +26,7 @@ import (
 
 const (
 	// ConsensusVersion defines the current x/profile module consensus version.
-	ConsensusVersion = 1
+	ConsensusVersion = 2
 )
 
 var (
@@ -33,6 +35,7 @@ var (
 	_ module.AppModule        = AppModule{}
 
 	_ autocli.HasAutoCLIConfig = AppModule{}
+	_ appmodule.HasEndBlocker  = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -76,7 +79,7 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEn
 	if err := data.Params.Validate(); err != nil {
 		return errorsmod.Wrap(err, "params")
 	}
-	return nil
+	return data.Validate()
 }
 
 func (a AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {
@@ -113,7 +116,7 @@ func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, messa
 	var genesisState types.GenesisState
 	marshaler.MustUnmarshalJSON(message, &genesisState)
 
-	if err := a.keeper.Params.Set(ctx, genesisState.Params); err != nil {
+	if err := a.keeper.InitGenesis(ctx, &genesisState); err != nil {
 		panic(err)
 	}
 
@@ -128,6 +131,11 @@ func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) jso
 func (a AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
 }
 
+// EndBlock removes the rate limit counters whose window has ended.
+func (a AppModule) EndBlock(ctx context.Context) error {
+	return a.keeper.EndBlocker(ctx)
+}
+
 func (a AppModule) QuerierRoute() string {
 	return types.QuerierRoute
 }
@@ -135,6 +143,11 @@ func (a AppModule) QuerierRoute() string {
 func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(a.keeper))
+
+	m := keeper.NewMigrator(a.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the
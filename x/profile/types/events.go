@@ -1,11 +1,34 @@
 package types
 
 const (
-	EventTypeAddProfile = "add_profile"
+	EventTypeAddProfile        = "add_profile"
+	EventTypeSetContentFilter  = "set_content_filter"
+	EventTypeBlockUser         = "block_user"
+	EventTypeUnblockUser       = "unblock_user"
+	EventTypeMute              = "mute"
+	EventTypeUnmute            = "unmute"
+	EventTypeSetPrivate        = "set_private"
+	EventTypeFollowRequest     = "follow_request"
+	EventTypeApproveFollow     = "approve_follow"
+	EventTypeRejectFollow      = "reject_follow"
+	EventTypeCreateList        = "create_list"
+	EventTypeRenameList        = "rename_list"
+	EventTypeDeleteList        = "delete_list"
+	EventTypeAddListMembers    = "add_list_members"
+	EventTypeRemoveListMembers = "remove_list_members"
 
 	AttributeKeyCreator    = "creator"
 	AttributeKeyNickname   = "nickname"
 	AttributeKeyUserHandle = "user_handle"
 	AttributeKeyAvatar     = "avatar"
 	AttributeKeyTimestamp  = "timestamp"
+	AttributeKeyLabels     = "labels"
+	AttributeKeyTarget     = "target"
+	AttributeKeyMuteType   = "mute_type"
+	AttributeKeyValue      = "value"
+	AttributeKeyPrivate    = "private"
+	AttributeKeyRequester  = "requester"
+	AttributeKeyListId     = "list_id"
+	AttributeKeyName       = "name"
+	AttributeKeyMembers    = "members"
 )
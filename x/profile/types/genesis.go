@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -14,6 +16,193 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	profiles := make(map[string]Profile, len(gs.Profiles))
+	profileHandles := make(map[string]bool, len(gs.Profiles))
+	for _, profile := range gs.Profiles {
+		if profile.WalletAddress == "" {
+			return WrapError(ErrInvalidGenesis, "profile wallet address cannot be empty")
+		}
+		if _, ok := profiles[profile.WalletAddress]; ok {
+			return WrapErrorf(ErrInvalidGenesis, "duplicate profile %s", profile.WalletAddress)
+		}
+		if profile.UserHandle != "" {
+			if profileHandles[profile.UserHandle] {
+				return WrapErrorf(ErrInvalidGenesis, "user handle %s is used by more than one profile", profile.UserHandle)
+			}
+			profileHandles[profile.UserHandle] = true
+		}
+		profiles[profile.WalletAddress] = profile
+	}
+
+	handles := make(map[string]bool, len(gs.UserHandles))
+	for _, handle := range gs.UserHandles {
+		if handle.UserHandle == "" || handles[handle.UserHandle] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate user handle %q", handle.UserHandle)
+		}
+		handles[handle.UserHandle] = true
+		profile, ok := profiles[handle.Address]
+		if !ok {
+			return WrapErrorf(ErrInvalidGenesis, "user handle %s references missing profile %s", handle.UserHandle, handle.Address)
+		}
+		if profile.UserHandle != handle.UserHandle {
+			return WrapErrorf(ErrInvalidGenesis, "user handle %s does not match the handle of profile %s", handle.UserHandle, handle.Address)
+		}
+	}
+
+	following := make(map[string]uint64)
+	followers := make(map[string]uint64)
+	follows := make(map[string]bool, len(gs.Follows))
+	for _, follow := range gs.Follows {
+		if follow.Follower == follow.Target {
+			return WrapErrorf(ErrInvalidGenesis, "%s cannot follow itself", follow.Follower)
+		}
+		edge := follow.Follower + ":" + follow.Target
+		if follows[edge] {
+			return WrapErrorf(ErrInvalidGenesis, "duplicate follow %s", edge)
+		}
+		follows[edge] = true
+		if _, ok := profiles[follow.Follower]; !ok {
+			return WrapErrorf(ErrInvalidGenesis, "follow %s references missing profile %s", edge, follow.Follower)
+		}
+		if _, ok := profiles[follow.Target]; !ok {
+			return WrapErrorf(ErrInvalidGenesis, "follow %s references missing profile %s", edge, follow.Target)
+		}
+		following[follow.Follower]++
+		followers[follow.Target]++
+	}
+	for _, profile := range gs.Profiles {
+		if profile.Following != following[profile.WalletAddress] {
+			return WrapErrorf(ErrInvalidGenesis, "profile %s has following %d but %d follow edges", profile.WalletAddress, profile.Following, following[profile.WalletAddress])
+		}
+		if profile.Followers != followers[profile.WalletAddress] {
+			return WrapErrorf(ErrInvalidGenesis, "profile %s has followers %d but %d follow edges", profile.WalletAddress, profile.Followers, followers[profile.WalletAddress])
+		}
+	}
+
+	blocks := make(map[string]bool, len(gs.Blocks))
+	for _, block := range gs.Blocks {
+		if block.Blocker == "" || block.Blocker == block.Blocked {
+			return WrapErrorf(ErrInvalidGenesis, "invalid block of %q by %q", block.Blocked, block.Blocker)
+		}
+		edge := block.Blocker + ":" + block.Blocked
+		if blocks[edge] {
+			return WrapErrorf(ErrInvalidGenesis, "duplicate block %s", edge)
+		}
+		blocks[edge] = true
+		if follows[edge] || follows[block.Blocked+":"+block.Blocker] {
+			return WrapErrorf(ErrInvalidGenesis, "blocked users %s still follow each other", edge)
+		}
+	}
+
+	requests := make(map[string]bool, len(gs.FollowRequests))
+	for _, request := range gs.FollowRequests {
+		if request.Requester == "" || request.Requester == request.Target {
+			return WrapErrorf(ErrInvalidGenesis, "invalid follow request of %q to %q", request.Requester, request.Target)
+		}
+		edge := request.Requester + ":" + request.Target
+		if requests[edge] {
+			return WrapErrorf(ErrInvalidGenesis, "duplicate follow request %s", edge)
+		}
+		requests[edge] = true
+		if follows[edge] {
+			return WrapErrorf(ErrInvalidGenesis, "follow request %s is already a follow", edge)
+		}
+		if blocks[edge] || blocks[request.Target+":"+request.Requester] {
+			return WrapErrorf(ErrInvalidGenesis, "follow request %s is between blocked users", edge)
+		}
+	}
 
-	return gs.Params.Validate()
+	lists := make(map[uint64]UserList, len(gs.Lists))
+	listsPerOwner := make(map[string]int)
+	for _, list := range gs.Lists {
+		if list.Id == 0 || list.Owner == "" {
+			return WrapErrorf(ErrInvalidGenesis, "list %d needs an id and an owner", list.Id)
+		}
+		if _, ok := lists[list.Id]; ok {
+			return WrapErrorf(ErrInvalidGenesis, "duplicate list %d", list.Id)
+		}
+		if err := ValidateListName(list.Name); err != nil {
+			return WrapErrorf(ErrInvalidGenesis, "list %d: %s", list.Id, err)
+		}
+		listsPerOwner[list.Owner]++
+		if listsPerOwner[list.Owner] > MaxListsPerOwner {
+			return WrapErrorf(ErrInvalidGenesis, "%s has more than %d lists", list.Owner, MaxListsPerOwner)
+		}
+		lists[list.Id] = list
+	}
+	listMembers := make(map[string]bool, len(gs.ListMembers))
+	for _, member := range gs.ListMembers {
+		if _, ok := lists[member.ListId]; !ok {
+			return WrapErrorf(ErrInvalidGenesis, "member %s references missing list %d", member.Address, member.ListId)
+		}
+		key := fmt.Sprintf("%d:%s", member.ListId, member.Address)
+		if member.Address == "" || listMembers[key] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate member %q of list %d", member.Address, member.ListId)
+		}
+		listMembers[key] = true
+	}
+
+	for _, mute := range gs.Mutes {
+		if mute.Address == "" {
+			return WrapError(ErrInvalidGenesis, "mute address cannot be empty")
+		}
+		value, err := NormalizeMuteValue(mute.MuteType, mute.Value)
+		if err != nil {
+			return WrapErrorf(ErrInvalidGenesis, "mute of %s: %s", mute.Address, err)
+		}
+		if value != mute.Value {
+			return WrapErrorf(ErrInvalidGenesis, "mute value %q of %s is not normalized", mute.Value, mute.Address)
+		}
+	}
+
+	auditIds := make(map[uint64]bool, len(gs.AuditLog))
+	for _, entry := range gs.AuditLog {
+		if auditIds[entry.Id] {
+			return WrapErrorf(ErrInvalidGenesis, "duplicate audit log entry %d", entry.Id)
+		}
+		auditIds[entry.Id] = true
+		if entry.Actor == "" || entry.Action == AuditAction_AUDIT_ACTION_UNSPECIFIED {
+			return WrapErrorf(ErrInvalidGenesis, "audit log entry %d needs an actor and an action", entry.Id)
+		}
+	}
+
+	for _, avatar := range gs.Avatars {
+		if avatar.Address == "" {
+			return WrapError(ErrInvalidGenesis, "avatar address cannot be empty")
+		}
+	}
+	for _, entry := range gs.UserSearch {
+		if entry.User.WalletAddress == "" {
+			return WrapError(ErrInvalidGenesis, "user search wallet address cannot be empty")
+		}
+	}
+	admins := make(map[string]bool, len(gs.Admins))
+	for _, admin := range gs.Admins {
+		if admin == "" || admins[admin] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate admin %q", admin)
+		}
+		admins[admin] = true
+	}
+	editableAdmins := make(map[string]bool, len(gs.EditableAdmins))
+	for _, admin := range gs.EditableAdmins {
+		if admin == "" || editableAdmins[admin] {
+			return WrapErrorf(ErrInvalidGenesis, "empty or duplicate editable admin %q", admin)
+		}
+		editableAdmins[admin] = true
+	}
+	for _, entry := range gs.Activities {
+		if entry.Address == "" {
+			return WrapError(ErrInvalidGenesis, "activity address cannot be empty")
+		}
+	}
+	for _, message := range gs.Messages {
+		if message.Receiver == "" || message.Sender == "" {
+			return WrapError(ErrInvalidGenesis, "message receiver and sender cannot be empty")
+		}
+	}
+	return nil
 }
@@ -24,6 +24,55 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "follow counts match the edges",
+			genState: &types.GenesisState{
+				Profiles: []types.Profile{
+					{WalletAddress: "alice", UserHandle: "alice", Followers: 1},
+					{WalletAddress: "bob", UserHandle: "bob", Following: 1},
+				},
+				UserHandles: []types.GenesisUserHandle{{UserHandle: "alice", Address: "alice"}},
+				Follows:     []types.GenesisFollow{{Follower: "bob", Target: "alice", Timestamp: 1}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate user handle",
+			genState: &types.GenesisState{
+				Profiles: []types.Profile{
+					{WalletAddress: "alice", UserHandle: "same"},
+					{WalletAddress: "bob", UserHandle: "same"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "user handle of another profile",
+			genState: &types.GenesisState{
+				Profiles:    []types.Profile{{WalletAddress: "alice", UserHandle: "alice"}},
+				UserHandles: []types.GenesisUserHandle{{UserHandle: "bob", Address: "alice"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "followers count does not match the edges",
+			genState: &types.GenesisState{
+				Profiles: []types.Profile{
+					{WalletAddress: "alice", UserHandle: "alice", Followers: 2},
+					{WalletAddress: "bob", UserHandle: "bob", Following: 1},
+				},
+				Follows: []types.GenesisFollow{{Follower: "bob", Target: "alice", Timestamp: 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "follow of a missing profile",
+			genState: &types.GenesisState{
+				Profiles: []types.Profile{{WalletAddress: "bob", UserHandle: "bob", Following: 1}},
+				Follows:  []types.GenesisFollow{{Follower: "bob", Target: "alice", Timestamp: 1}},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProfileHooks lets modules that index state by profile react to profile changes.
+type ProfileHooks interface {
+	// AfterPrivacyChanged is called once an account has turned private or public.
+	AfterPrivacyChanged(ctx sdk.Context, address string, private bool)
+}
@@ -20,6 +20,13 @@ const (
 
 	AuthorityKeyPrefix              = "Authority/admin/"
 	AuthorityEditableAdminKeyPrefix = "Authority/editable/admin/"
+	AuthorityAdminLevelKeyPrefix    = "Authority/level/"
+
+	// the audit log is append only, indexed by actor and by action
+	AuditLogKeyPrefix       = "Audit/log/"
+	AuditLogCountKey        = "Audit/count"
+	AuditLogActorKeyPrefix  = "Audit/actor/"
+	AuditLogActionKeyPrefix = "Audit/action/"
 
 	ProfileKeyPrefix           = "Profile/value/"
 	ProfileAvatarPrefix        = "Profile/avatar/"
@@ -31,6 +38,26 @@ const (
 	ProfileFollowersPrefix       = "Profile/followers/"
 	ProfileFollowTimePrefix      = "Profile/follow/time/"
 
+	// follow requests to private accounts are listed per target and per
+	// requester, newest first
+	ProfileFollowRequestPrefix     = "Profile/followRequest/received/"
+	ProfileFollowRequestSentPrefix = "Profile/followRequest/sent/"
+	ProfileFollowRequestTimePrefix = "Profile/followRequest/time/"
+
+	ProfileBlockedPrefix   = "Profile/blocked/"
+	ProfileBlockTimePrefix = "Profile/block/time/"
+	ProfileMutePrefix      = "Profile/mute/"
+	RateLimitCounterPrefix = "Profile/ratelimit/"
+	// RateLimitQueuePrefix queues the counters under the end of their window
+	RateLimitQueuePrefix = "Profile/ratelimitQueue/"
+
+	// user lists are keyed by id, indexed by owner, with their members keyed
+	// by list id and address
+	UserListKeyPrefix       = "List/value/"
+	UserListOwnerKeyPrefix  = "List/owner/"
+	UserListMemberKeyPrefix = "List/member/"
+	UserListCountKey        = "List/count"
+
 	ActivitiesReceivedPrefix      = "Activities/received/"
 	ActivitiesReceivedCountPrefix = "Activities/received/count/"
 
@@ -39,6 +66,8 @@ const (
 	AdminActionRemove       = "remove"
 
 	PageSize = 10
+	// MaxPageLimit bounds the limit a paginated query accepts
+	MaxPageLimit = 100
 
 	UserSearchFixedLength = 36
 	UserSearchPaddingChar = 0
@@ -46,6 +75,30 @@ const (
 	ProfileMessagePrefix      = "Profile/Messages/"
 	ProfileMessageCountPrefix = "Profile/Messages/count/"
 	ProfileMaxMessagesPerPair = 20
+
+	MaxExcludedLabels = 10
+
+	// content labels a post can carry, x/post validates post labels against them
+	LabelNSFW      = "nsfw"
+	LabelSensitive = "sensitive"
+	LabelSpoiler   = "spoiler"
+
+	MaxMutesPerType      = 100
+	MaxMuteValueLength   = 64
+	MaxMuteKeywordLength = 50
+
+	MaxListsPerOwner      = 20
+	MaxListNameLength     = 50
+	DefaultMaxListMembers = 100
+	MaxListMembersLimit   = 500
+
+	// MaxGraphScan bounds the follow list entries a graph query walks
+	MaxGraphScan = 1000
+
+	// RateLimitWindow is the length in seconds of the hourly rate limit window
+	RateLimitWindow = 60 * 60
+	// MaxRateLimitPrunesPerBlock bounds the work of the EndBlocker
+	MaxRateLimitPrunesPerBlock = 100
 )
 
 var ORMModuleSchema = ormv1alpha1.ModuleSchemaDescriptor{
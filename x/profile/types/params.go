@@ -11,6 +11,9 @@ const (
 	KeySomeValue      = "someValue"
 	KeyAdminAddress   = "adminAddress"
 	KeyChiefModerator = "chiefModerator"
+	KeyRateLimits     = "rateLimits"
+	KeyLevelBonus     = "rateLimitLevelBonus"
+	KeyMaxListMembers = "maxListMembers"
 )
 
 // DefaultParams returns default module parameters.
@@ -19,6 +22,21 @@ func DefaultParams() Params {
 		SomeValue:      true,
 		AdminAddress:   "tlock1hj5fveer5cjtn4wd6wstzugjfdxzl0xp5u7j9p",
 		ChiefModerator: "tlock1wfvjqmkekyuy59r535nm2ca3yjkf706nu8x49r",
+		RateLimits:     DefaultRateLimits(),
+		// every level adds a tenth of the base quota
+		RateLimitLevelBonus: 10,
+		MaxListMembers:      DefaultMaxListMembers,
+	}
+}
+
+// DefaultRateLimits returns the default quotas of the social messages.
+func DefaultRateLimits() []RateLimit {
+	return []RateLimit{
+		{MsgType: "/post.v1.MsgCreatePost", PerBlock: 3, PerHour: 60},
+		{MsgType: "/post.v1.MsgCommentRequest", PerBlock: 5, PerHour: 300},
+		{MsgType: "/post.v1.MsgLikeRequest", PerBlock: 20, PerHour: 1000},
+		{MsgType: "/profile.v1.MsgFollowRequest", PerBlock: 10, PerHour: 200},
+		{MsgType: "/profile.v1.SendMessageRequest", PerBlock: 5, PerHour: 200},
 	}
 }
 
@@ -47,6 +65,18 @@ func (p Params) Validate() error {
 		return WrapErrorf(ErrInvalidParameter, "invalid ChiefModerator: %v", err)
 	}
 
+	if err := validateRateLimits(p.RateLimits); err != nil {
+		return WrapErrorf(ErrInvalidParameter, "invalid RateLimits: %v", err)
+	}
+
+	if err := validateLevelBonus(p.RateLimitLevelBonus); err != nil {
+		return WrapErrorf(ErrInvalidParameter, "invalid RateLimitLevelBonus: %v", err)
+	}
+
+	if err := validateMaxListMembers(p.MaxListMembers); err != nil {
+		return WrapErrorf(ErrInvalidParameter, "invalid MaxListMembers: %v", err)
+	}
+
 	return nil
 }
 
@@ -59,6 +89,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair([]byte(KeySomeValue), &p.SomeValue, validateSomeValue),
 		paramstypes.NewParamSetPair([]byte(KeyAdminAddress), &p.AdminAddress, validateAddress),
 		paramstypes.NewParamSetPair([]byte(KeyChiefModerator), &p.ChiefModerator, validateAddress),
+		paramstypes.NewParamSetPair([]byte(KeyRateLimits), &p.RateLimits, validateRateLimits),
+		paramstypes.NewParamSetPair([]byte(KeyLevelBonus), &p.RateLimitLevelBonus, validateLevelBonus),
+		paramstypes.NewParamSetPair([]byte(KeyMaxListMembers), &p.MaxListMembers, validateMaxListMembers),
 	}
 }
 
@@ -90,6 +123,47 @@ func validateAddress(i interface{}) error {
 	return nil
 }
 
+func validateRateLimits(i interface{}) error {
+	limits, ok := i.([]RateLimit)
+	if !ok {
+		return NewInvalidParameterErrorf("invalid parameter type: %T, expected []RateLimit", i)
+	}
+
+	seen := make(map[string]bool, len(limits))
+	for _, limit := range limits {
+		if !strings.HasPrefix(limit.MsgType, "/") {
+			return NewInvalidParameterErrorf("message type %q must be a type url", limit.MsgType)
+		}
+		if seen[limit.MsgType] {
+			return NewInvalidParameterErrorf("duplicate rate limit for %s", limit.MsgType)
+		}
+		seen[limit.MsgType] = true
+	}
+	return nil
+}
+
+func validateLevelBonus(i interface{}) error {
+	bonus, ok := i.(uint64)
+	if !ok {
+		return NewInvalidParameterErrorf("invalid parameter type: %T, expected uint64", i)
+	}
+	if bonus > 1000 {
+		return NewInvalidParameterErrorf("level bonus %d cannot exceed 1000 percent", bonus)
+	}
+	return nil
+}
+
+func validateMaxListMembers(i interface{}) error {
+	max, ok := i.(uint64)
+	if !ok {
+		return NewInvalidParameterErrorf("invalid parameter type: %T, expected uint64", i)
+	}
+	if max > MaxListMembersLimit {
+		return NewInvalidParameterErrorf("max list members %d cannot exceed %d", max, MaxListMembersLimit)
+	}
+	return nil
+}
+
 // NewInvalidParameterErrorf creates a new invalid parameter error with formatting
 func NewInvalidParameterErrorf(format string, args ...interface{}) error {
 	return WrapErrorf(ErrInvalidParameter, format, args...)
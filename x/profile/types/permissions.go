@@ -0,0 +1,54 @@
+package types
+
+// rolePermissions maps every role to the permissions it grants.
+var rolePermissions = map[Role][]Permission{
+	Role_ROLE_MODERATOR: {
+		Permission_PERMISSION_MODERATE_POSTS,
+	},
+	Role_ROLE_EDITOR: {
+		Permission_PERMISSION_MODERATE_POSTS,
+		Permission_PERMISSION_EDIT_TOPICS,
+	},
+	Role_ROLE_ADMIN: {
+		Permission_PERMISSION_MODERATE_POSTS,
+		Permission_PERMISSION_EDIT_TOPICS,
+		Permission_PERMISSION_MANAGE_CATEGORIES,
+	},
+	Role_ROLE_SUPER_ADMIN: {
+		Permission_PERMISSION_MODERATE_POSTS,
+		Permission_PERMISSION_EDIT_TOPICS,
+		Permission_PERMISSION_MANAGE_CATEGORIES,
+		Permission_PERMISSION_MANAGE_ADMINS,
+	},
+}
+
+// Permissions returns the permissions granted by the role
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// HasPermission reports whether the role grants permission
+func (r Role) HasPermission(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleForAdminLevel returns the role of a profile admin level
+func RoleForAdminLevel(level uint64) Role {
+	switch {
+	case level >= 5:
+		return Role_ROLE_SUPER_ADMIN
+	case level >= 3:
+		return Role_ROLE_ADMIN
+	case level == 2:
+		return Role_ROLE_EDITOR
+	case level == 1:
+		return Role_ROLE_MODERATOR
+	default:
+		return Role_ROLE_NONE
+	}
+}
@@ -22,6 +22,9 @@ var (
 	ErrResourceNotFound      = errorsmod.Register(ModuleName, 1109, "resource not found")
 	ErrInvalidNickname       = errorsmod.Register(ModuleName, 1110, "invalid nickname")
 	ErrValidationFailed      = errorsmod.Register(ModuleName, 1111, "validation failed")
+	ErrInvalidGenesis        = errorsmod.Register(ModuleName, 1112, "invalid genesis state")
+	ErrUserBlocked           = errorsmod.Register(ModuleName, 1113, "user is blocked")
+	ErrRateLimited           = errorsmod.Register(ModuleName, 1114, "rate limit exceeded")
 )
 
 // Error helper functions
@@ -87,6 +90,8 @@ func ToGRPCError(err error) error {
 		return status.Error(codes.PermissionDenied, err.Error())
 	case errorsmod.IsOf(err, ErrCannotFollowSelf):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errorsmod.IsOf(err, ErrRateLimited):
+		return status.Error(codes.ResourceExhausted, err.Error())
 	case errorsmod.IsOf(err, ErrDatabaseOperation):
 		return status.Error(codes.Internal, err.Error())
 	default:
@@ -44,3 +44,71 @@ func ValidateNickname(nickname string) (bool, error) {
 	}
 	return true, nil
 }
+
+// IsContentLabel reports whether label is one of the content labels a post can carry
+func IsContentLabel(label string) bool {
+	switch label {
+	case LabelNSFW, LabelSensitive, LabelSpoiler:
+		return true
+	}
+	return false
+}
+
+// ValidateExcludedLabels validates the content labels of a feed filter
+func ValidateExcludedLabels(labels []string) error {
+	if len(labels) > MaxExcludedLabels {
+		return NewInvalidRequestErrorf("at most %d labels can be excluded", MaxExcludedLabels)
+	}
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		if !IsContentLabel(label) {
+			return NewInvalidRequestErrorf("unknown content label %q", label)
+		}
+		if seen[label] {
+			return NewInvalidRequestErrorf("duplicate label %s", label)
+		}
+		seen[label] = true
+	}
+	return nil
+}
+
+// NormalizeMuteValue validates the value of a mute rule and returns it in the
+// form it is stored and matched in, keywords are matched case-insensitively.
+func NormalizeMuteValue(muteType MuteType, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", NewInvalidRequestError("mute value cannot be empty")
+	}
+	switch muteType {
+	case MuteType_MUTE_TYPE_ACCOUNT, MuteType_MUTE_TYPE_TOPIC:
+		if len(value) > MaxMuteValueLength {
+			return "", NewInvalidRequestErrorf("mute value cannot exceed %d characters", MaxMuteValueLength)
+		}
+		return value, nil
+	case MuteType_MUTE_TYPE_KEYWORD:
+		if len(value) > MaxMuteKeywordLength {
+			return "", NewInvalidRequestErrorf("muted keyword cannot exceed %d characters", MaxMuteKeywordLength)
+		}
+		return strings.ToLower(value), nil
+	default:
+		return "", NewInvalidRequestErrorf("invalid mute type %d", muteType)
+	}
+}
+
+// ValidateListName validates the name of a user list
+func ValidateListName(name string) error {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		return NewInvalidRequestError("list name cannot be empty")
+	}
+	if len(trimmed) > MaxListNameLength {
+		return NewInvalidRequestErrorf("list name must be %d characters or less", MaxListNameLength)
+	}
+	return nil
+}
+
+// VisibleTo reports whether viewer can see the list, private lists are only
+// visible to their owner
+func (l UserList) VisibleTo(viewer string) bool {
+	return !l.Private || viewer == l.Owner
+}